@@ -4,32 +4,53 @@ import "time"
 
 // Add adds a duration to the JalaliDateTime.
 func (j JalaliDateTime) Add(d time.Duration) JalaliDateTime {
-	return ToJalaliDateTime(j.ToTime().Add(d))
+	return ToJalaliDateTime(j.t.Add(d))
 }
 
-// Sub returns the duration between two JalaliDateTimes.
+// Sub returns the duration between two JalaliDateTimes. Like time.Time.Sub,
+// it uses the monotonic clock readings when both values carry one, as
+// values from Now do, so it measures elapsed time correctly even if the
+// wall clock is changed in between.
 func (j JalaliDateTime) Sub(u JalaliDateTime) time.Duration {
-	return j.ToTime().Sub(u.ToTime())
+	return j.t.Sub(u.t)
+}
+
+// Since returns the time elapsed since j. It is shorthand for
+// Now().Sub(j).
+func Since(j JalaliDateTime) time.Duration {
+	return time.Since(j.t)
+}
+
+// Until returns the duration until j. It is shorthand for j.Sub(Now()).
+func Until(j JalaliDateTime) time.Duration {
+	return time.Until(j.t)
 }
 
 // After returns true if j is after u.
 func (x JalaliDateTime) After(y JalaliDateTime) bool {
-	return x.UnixNano() > y.UnixNano()
+	return x.t.After(y.t)
 }
 
 // Before returns true if j is before u.
 func (x JalaliDateTime) Before(y JalaliDateTime) bool {
-	return x.UnixNano() < y.UnixNano()
+	return x.t.Before(y.t)
+}
+
+// Equal reports whether x and y represent the same instant, even when they
+// are in different locations. As with time.Time, prefer Equal to ==, which
+// also compares the location and the monotonic clock reading.
+func (x JalaliDateTime) Equal(y JalaliDateTime) bool {
+	return x.t.Equal(y.t)
 }
 
 // Unix returns the Unix timestamp.
 func (jdt JalaliDateTime) Unix() int64 {
-	return jdt.ToTime().Unix()
+	return jdt.t.Unix()
 }
 
 // UnixNano returns the Unix nano timestamp (UTC).
 func (jdt JalaliDateTime) UnixNano() int64 {
-	return jdt.ToTime().UTC().UnixNano()
+	return jdt.t.UnixNano()
 }
 
 // DaysInBetween returns the number of days between two dates.
@@ -51,16 +72,7 @@ func (j JalaliDateTime) AddYears(n int) JalaliDateTime {
 	if j.IsLeapJalaliYear() && j.month == Esfand && j.day == 30 {
 		j.day = 29
 	}
-	return JalaliDateTime{
-		year:     updatedYear,
-		month:    j.month,
-		day:      j.day,
-		hour:     j.hour,
-		min:      j.min,
-		sec:      j.sec,
-		nanosec:  j.nanosec,
-		location: j.location,
-	}
+	return newJalali(updatedYear, j.month, j.day, j.hour, j.min, j.sec, j.nanosec, j.location)
 }
 
 // AddMonths adds months, adjusting day if needed.
@@ -72,25 +84,18 @@ func (j JalaliDateTime) AddMonths(n int) JalaliDateTime {
 	if updatedMonth > 12 {
 		updatedYear++
 		updatedMonth -= 12
+	} else if updatedMonth < 1 {
+		updatedYear--
+		updatedMonth += 12
 	}
 	days := daysInMonth(updatedYear, Month(updatedMonth))
 	if j.day > days {
 		j.day = days
 	}
-	return JalaliDateTime{
-		year:     updatedYear,
-		month:    Month(updatedMonth),
-		day:      j.day,
-		hour:     j.hour,
-		min:      j.min,
-		sec:      j.sec,
-		nanosec:  j.nanosec,
-		location: j.location,
-	}
+	return newJalali(updatedYear, Month(updatedMonth), j.day, j.hour, j.min, j.sec, j.nanosec, j.location)
 }
 
 // AddDays adds days using Gregorian equivalent.
 func (j JalaliDateTime) AddDays(n int) JalaliDateTime {
-	t := j.ToTime().AddDate(0, 0, n)
-	return ToJalaliDateTime(t)
+	return ToJalaliDateTime(j.t.AddDate(0, 0, n))
 }
\ No newline at end of file
@@ -1,6 +1,7 @@
 package golali_test
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -40,4 +41,95 @@ func TestDaysInBetween(t *testing.T) {
 	if days := b.DaysInBetween(a); days != 10 { // order independent
 		t.Errorf("DaysInBetween reverse = %d, want 10", days)
 	}
-}
\ No newline at end of file
+}
+
+func TestAddMonthsNegative(t *testing.T) {
+	j := golali.Date(1403, golali.Ordibehesht, 31, 0, 0, 0, 0, time.UTC)
+	j2 := j.AddMonths(-2)
+	if j2.Year() != 1402 || j2.Month() != golali.Esfand || j2.Day() != 29 {
+		t.Errorf("AddMonths(-2) from 1403/02/31 = %v, want 1402/12/29", j2)
+	}
+	if j3 := j.AddMonths(-14); j3.Year() != 1401 || j3.Month() != golali.Esfand || j3.Day() != 29 {
+		t.Errorf("AddMonths(-14) from 1403/02/31 = %v, want 1401/12/29", j3)
+	}
+}
+
+func TestInstantIsKept(t *testing.T) {
+	irst := golali.IRST()
+	tm := time.Date(2024, time.October, 6, 10, 20, 30, 123456789, irst)
+	j := golali.ToJalaliDateTime(tm)
+	if got := j.ToTime(); !got.Equal(tm) || got.Location() != irst {
+		t.Errorf("ToTime() = %v, want %v", got, tm)
+	}
+	if j.Unix() != tm.Unix() || j.UnixNano() != tm.UnixNano() {
+		t.Errorf("Unix = %d/%d, want %d/%d", j.Unix(), j.UnixNano(), tm.Unix(), tm.UnixNano())
+	}
+
+	// Comparisons are exact to the nanosecond and independent of the zone.
+	next := j.Add(time.Nanosecond)
+	if !j.Before(next) || !next.After(j) || next.Sub(j) != time.Nanosecond {
+		t.Error("a one nanosecond difference was lost")
+	}
+	if j.UTC().Before(j) || j.UTC().After(j) || j.UTC().Sub(j) != 0 {
+		t.Error("the same instant in another zone compares unequal")
+	}
+
+	// A nil location means local time.
+	local := golali.Date(1403, golali.Mehr, 15, 10, 0, 0, 0, nil)
+	if local.Weekday() != golali.Yekshanbe {
+		t.Errorf("Weekday() with nil location = %v", local.Weekday())
+	}
+	if !local.ToTime().Equal(time.Date(2024, time.October, 6, 10, 0, 0, 0, time.Local)) {
+		t.Errorf("ToTime() with nil location = %v", local.ToTime())
+	}
+}
+
+func BenchmarkUnix(b *testing.B) {
+	j := golali.Date(1403, golali.Mehr, 15, 10, 20, 30, 0, golali.IRST())
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = j.Unix()
+	}
+}
+
+func TestMonotonicReading(t *testing.T) {
+	start := golali.Now()
+	if !strings.Contains(start.ToTime().String(), " m=") {
+		t.Fatalf("Now() dropped the monotonic reading: %v", start.ToTime())
+	}
+	if strings.Contains(start.UTC().ToTime().String(), " m=") {
+		t.Error("UTC() kept the monotonic reading")
+	}
+	if !strings.Contains(start.Add(time.Hour).ToTime().String(), " m=") {
+		t.Error("Add dropped the monotonic reading")
+	}
+
+	time.Sleep(time.Millisecond)
+	end := golali.Now()
+	if d := end.Sub(start); d < time.Millisecond {
+		t.Errorf("Sub = %v, want at least 1ms", d)
+	}
+	if !start.Before(end) || !end.After(start) {
+		t.Error("Before/After disagree with the order of Now calls")
+	}
+	if d := golali.Since(start); d < time.Millisecond {
+		t.Errorf("Since = %v, want at least 1ms", d)
+	}
+	if d := golali.Until(start.Add(time.Hour)); d <= 0 || d > time.Hour {
+		t.Errorf("Until = %v, want just under an hour", d)
+	}
+}
+
+func TestEqual(t *testing.T) {
+	j := golali.Date(1403, golali.Mehr, 15, 10, 20, 30, 0, golali.IRST())
+	if !j.Equal(j.UTC()) || !j.UTC().Equal(j.In(golali.AFT())) {
+		t.Error("the same instant in different zones is not Equal")
+	}
+	if j.Equal(j.Add(time.Nanosecond)) {
+		t.Error("different instants are Equal")
+	}
+	now := golali.Now()
+	if !now.Equal(golali.ToJalaliDateTime(now.ToTime().Round(0))) {
+		t.Error("stripping the monotonic reading changed Equal")
+	}
+}
@@ -0,0 +1,124 @@
+package golali
+
+// HolidaySource reports whether a date is a public holiday. The holidays
+// subpackage provides Iran's official holidays:
+//
+//	golali.HolidayFunc(holidays.IsHoliday)
+type HolidaySource interface {
+	IsHoliday(j JalaliDateTime) bool
+}
+
+// HolidayFunc adapts a function to a HolidaySource.
+type HolidayFunc func(j JalaliDateTime) bool
+
+// IsHoliday returns f(j).
+func (f HolidayFunc) IsHoliday(j JalaliDateTime) bool {
+	return f(j)
+}
+
+// BusinessCalendar decides which days are working days, for payroll,
+// delivery estimates and deadlines. The zero value closes on Joomeh only and
+// knows no holidays.
+//
+// Only the date of a JalaliDateTime is considered; the methods that return
+// a date keep the time of day and location of their argument.
+type BusinessCalendar struct {
+	// Weekend lists the weekdays without work. A nil Weekend means Joomeh
+	// alone; an empty, non-nil one means every weekday is worked.
+	Weekend []Weekday
+
+	// HalfDays lists working weekdays with shortened hours, such as
+	// Panjshanbe in many offices. They count as business days; IsHalfDay
+	// tells them apart.
+	HalfDays []Weekday
+
+	// Holidays reports the public holidays. A nil Holidays means none.
+	Holidays HolidaySource
+}
+
+// maxClosedDays bounds the search for a business day, so that a calendar
+// without any is reported instead of looping forever.
+const maxClosedDays = 400
+
+// IsBusinessDay reports whether the date of j is a working day: neither a
+// weekend day nor a holiday.
+func (c BusinessCalendar) IsBusinessDay(j JalaliDateTime) bool {
+	weekend := c.Weekend
+	if weekend == nil {
+		weekend = []Weekday{Joomeh}
+	}
+	if containsWeekday(weekend, j.Weekday()) {
+		return false
+	}
+	return c.Holidays == nil || !c.Holidays.IsHoliday(j)
+}
+
+// IsHalfDay reports whether the date of j is a business day with shortened
+// hours.
+func (c BusinessCalendar) IsHalfDay(j JalaliDateTime) bool {
+	return containsWeekday(c.HalfDays, j.Weekday()) && c.IsBusinessDay(j)
+}
+
+// NextBusinessDay returns the first business day after the date of j.
+// It panics if the calendar has no business day within a year.
+func (c BusinessCalendar) NextBusinessDay(j JalaliDateTime) JalaliDateTime {
+	return c.step(j, 1)
+}
+
+// AddBusinessDays moves j forward by n business days, or back for negative
+// n. The days skipped over do not need to be business days, but the result
+// is one unless n is zero, in which case j is returned unchanged: adding 1
+// to a Joomeh gives the Shanbe after it.
+// It panics if the calendar has no business day within a year.
+func (c BusinessCalendar) AddBusinessDays(j JalaliDateTime, n int) JalaliDateTime {
+	dir := 1
+	if n < 0 {
+		dir, n = -1, -n
+	}
+	for ; n > 0; n-- {
+		j = c.step(j, dir)
+	}
+	return j
+}
+
+// BusinessDaysBetween returns the number of business days from the date of a
+// up to, but not including, the date of b. It is negative when b is before
+// a, so that c.AddBusinessDays(a, c.BusinessDaysBetween(a, b)) is b for
+// business days a and b.
+func (c BusinessCalendar) BusinessDaysBetween(a, b JalaliDateTime) int {
+	from := jalaliDayNumber(a.year, a.month, a.day)
+	to := jalaliDayNumber(b.year, b.month, b.day)
+	sign := 1
+	if to < from {
+		a, from, to, sign = b, to, from, -1
+	}
+	n := 0
+	for d := a; from < to; from++ {
+		if c.IsBusinessDay(d) {
+			n++
+		}
+		d = d.AddDays(1)
+	}
+	return sign * n
+}
+
+// step returns the nearest business day after (dir 1) or before (dir -1)
+// the date of j.
+func (c BusinessCalendar) step(j JalaliDateTime, dir int) JalaliDateTime {
+	for i := 0; i < maxClosedDays; i++ {
+		j = j.AddDays(dir)
+		if c.IsBusinessDay(j) {
+			return j
+		}
+	}
+	panic("golali: BusinessCalendar has no business days")
+}
+
+func containsWeekday(days []Weekday, w Weekday) bool {
+	for _, d := range days {
+		if d == w {
+			return true
+		}
+	}
+	return false
+}
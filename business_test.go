@@ -0,0 +1,92 @@
+package golali_test
+
+import (
+	"testing"
+
+	"github.com/bijanghanei/golali"
+	"github.com/bijanghanei/golali/holidays"
+)
+
+func TestBusinessCalendarWeekend(t *testing.T) {
+	thursday := golali.Date(1404, golali.Mehr, 3, 9, 0, 0, 0, golali.IRST())
+	friday := thursday.AddDays(1)
+	if thursday.Weekday() != golali.Panjshanbe || friday.Weekday() != golali.Joomeh {
+		t.Fatalf("weekdays = %v, %v", thursday.Weekday(), friday.Weekday())
+	}
+
+	var c golali.BusinessCalendar
+	if !c.IsBusinessDay(thursday) || c.IsBusinessDay(friday) {
+		t.Error("zero BusinessCalendar should close on Joomeh only")
+	}
+	if c.IsHalfDay(thursday) {
+		t.Error("zero BusinessCalendar has no half days")
+	}
+
+	c.HalfDays = []golali.Weekday{golali.Panjshanbe}
+	if !c.IsBusinessDay(thursday) || !c.IsHalfDay(thursday) {
+		t.Error("Panjshanbe should be a half business day")
+	}
+
+	c = golali.BusinessCalendar{Weekend: []golali.Weekday{golali.Panjshanbe, golali.Joomeh}}
+	if c.IsBusinessDay(thursday) || c.IsBusinessDay(friday) {
+		t.Error("Panjshanbe and Joomeh should both be closed")
+	}
+
+	c = golali.BusinessCalendar{Weekend: []golali.Weekday{}}
+	if !c.IsBusinessDay(friday) {
+		t.Error("an empty Weekend should work every day")
+	}
+}
+
+func TestBusinessCalendarHolidays(t *testing.T) {
+	c := golali.BusinessCalendar{Holidays: golali.HolidayFunc(holidays.IsHoliday)}
+	date := func(y int, m golali.Month, d int) golali.JalaliDateTime {
+		return golali.Date(y, m, d, 10, 30, 0, 0, golali.IRST())
+	}
+
+	tests := []struct {
+		from golali.JalaliDateTime
+		n    int
+		want golali.JalaliDateTime
+	}{
+		{date(1403, golali.Esfand, 28), 1, date(1403, golali.Esfand, 30)},   // 29 Esfand is a holiday
+		{date(1403, golali.Esfand, 30), 1, date(1404, golali.Farvardin, 5)}, // Nowruz
+		{date(1404, golali.Farvardin, 5), -1, date(1403, golali.Esfand, 30)},
+		{date(1404, golali.Mehr, 1), 5, date(1404, golali.Mehr, 7)},
+		{date(1404, golali.Mehr, 4), 0, date(1404, golali.Mehr, 4)},
+	}
+	for _, tt := range tests {
+		got := c.AddBusinessDays(tt.from, tt.n)
+		if !got.Equal(tt.want) {
+			t.Errorf("AddBusinessDays(%v, %d) = %v, want %v", tt.from, tt.n, got, tt.want)
+		}
+		if tt.n != 0 {
+			if back := c.BusinessDaysBetween(tt.from, got); back != tt.n {
+				t.Errorf("BusinessDaysBetween(%v, %v) = %d, want %d", tt.from, got, back, tt.n)
+			}
+		}
+	}
+
+	if got := c.NextBusinessDay(date(1404, golali.Bahman, 21)); !got.Equal(date(1404, golali.Bahman, 23)) {
+		t.Errorf("NextBusinessDay(1404/11/21) = %v", got)
+	}
+	if got := c.BusinessDaysBetween(date(1404, golali.Mehr, 1), date(1404, golali.Mehr, 8)); got != 6 {
+		t.Errorf("BusinessDaysBetween over a week = %d, want 6", got)
+	}
+	if got := c.BusinessDaysBetween(date(1404, golali.Mehr, 8), date(1404, golali.Mehr, 1)); got != -6 {
+		t.Errorf("BusinessDaysBetween backwards = %d, want -6", got)
+	}
+}
+
+func TestBusinessCalendarNoBusinessDays(t *testing.T) {
+	c := golali.BusinessCalendar{Weekend: []golali.Weekday{
+		golali.Shanbe, golali.Yekshanbe, golali.Doshanbe, golali.Seshanbe,
+		golali.Chaharshanbe, golali.Panjshanbe, golali.Joomeh,
+	}}
+	defer func() {
+		if recover() == nil {
+			t.Error("NextBusinessDay did not panic")
+		}
+	}()
+	c.NextBusinessDay(golali.Date(1404, golali.Mehr, 1, 0, 0, 0, 0, golali.IRST()))
+}
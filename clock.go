@@ -0,0 +1,232 @@
+package golali
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// Clock is a source of the current time and of timers, so that code built
+// on golali can be tested without waiting for real dates. SystemClock uses
+// the time package; ManualClock is moved by hand in tests.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+
+	// After waits for the duration to elapse and then sends the current
+	// time on the returned channel.
+	After(d time.Duration) <-chan time.Time
+
+	// NewTimer creates a Timer that sends the current time on its channel
+	// after at least duration d.
+	NewTimer(d time.Duration) Timer
+
+	// NewTicker returns a Ticker that sends the current time on its channel
+	// every period d. It panics if d <= 0.
+	NewTicker(d time.Duration) Ticker
+}
+
+// Timer is a single event, like time.Timer.
+type Timer interface {
+	C() <-chan time.Time
+	Stop() bool
+	Reset(d time.Duration) bool
+}
+
+// Ticker delivers ticks at intervals, like time.Ticker.
+type Ticker interface {
+	C() <-chan time.Time
+	Stop()
+	Reset(d time.Duration)
+}
+
+// SystemClock is the Clock of the time package.
+var SystemClock Clock = systemClock{}
+
+// NowFrom returns the current JalaliDateTime according to c.
+func NowFrom(c Clock) JalaliDateTime {
+	return ToJalaliDateTime(c.Now())
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time                         { return time.Now() }
+func (systemClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+func (systemClock) NewTimer(d time.Duration) Timer         { return systemTimer{time.NewTimer(d)} }
+func (systemClock) NewTicker(d time.Duration) Ticker       { return systemTicker{time.NewTicker(d)} }
+
+type systemTimer struct{ t *time.Timer }
+
+func (t systemTimer) C() <-chan time.Time        { return t.t.C }
+func (t systemTimer) Stop() bool                 { return t.t.Stop() }
+func (t systemTimer) Reset(d time.Duration) bool { return t.t.Reset(d) }
+
+type systemTicker struct{ t *time.Ticker }
+
+func (t systemTicker) C() <-chan time.Time   { return t.t.C }
+func (t systemTicker) Stop()                 { t.t.Stop() }
+func (t systemTicker) Reset(d time.Duration) { t.t.Reset(d) }
+
+// ManualClock is a Clock whose time only changes when Set or Advance is
+// called. Timers and tickers fire, in order, as the clock passes their
+// deadlines. Like their time package counterparts, their channels hold one
+// value; a tick that finds the channel full is dropped.
+//
+// A ManualClock is safe for concurrent use.
+type ManualClock struct {
+	mu      sync.Mutex
+	now     time.Time
+	waiters []*manualWaiter
+}
+
+// NewManualClock returns a ManualClock set to start.
+func NewManualClock(start time.Time) *ManualClock {
+	return &ManualClock{now: start}
+}
+
+// Now returns the time the clock is set to.
+func (c *ManualClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// Set moves the clock to t, firing the timers and tickers due by then.
+// Moving the clock backwards fires nothing.
+func (c *ManualClock) Set(t time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.moveTo(t)
+}
+
+// Advance moves the clock forward by d, firing the timers and tickers due
+// by then.
+func (c *ManualClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.moveTo(c.now.Add(d))
+}
+
+// moveTo advances the clock to t one deadline at a time, so that each
+// waiter sees the time it was due. A ticker fires once, at its first due
+// tick: its channel holds one value, so the later ticks would be dropped,
+// and it jumps straight to its first deadline after t. c.mu must be held.
+func (c *ManualClock) moveTo(t time.Time) {
+	sort.SliceStable(c.waiters, func(i, k int) bool { return c.waiters[i].when.Before(c.waiters[k].when) })
+	n := len(c.waiters)
+	kept := c.waiters[:0]
+	for _, w := range c.waiters {
+		if w.when.After(t) {
+			kept = append(kept, w)
+			continue
+		}
+		if w.when.After(c.now) {
+			c.now = w.when
+		}
+		select {
+		case w.c <- c.now:
+		default:
+		}
+		if w.period > 0 {
+			w.when = w.when.Add((t.Sub(w.when)/w.period + 1) * w.period)
+			kept = append(kept, w)
+		}
+	}
+	clear(c.waiters[len(kept):n])
+	c.waiters = kept
+	c.now = t
+}
+
+// After returns a channel that receives the clock's time once it has been
+// advanced by d.
+func (c *ManualClock) After(d time.Duration) <-chan time.Time {
+	return c.NewTimer(d).C()
+}
+
+// NewTimer returns a Timer that fires once the clock has been advanced by d.
+func (c *ManualClock) NewTimer(d time.Duration) Timer {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	w := &manualWaiter{clock: c, c: make(chan time.Time, 1)}
+	c.schedule(w, d)
+	return w
+}
+
+// NewTicker returns a Ticker that fires each time the clock passes another
+// period d. It panics if d <= 0.
+func (c *ManualClock) NewTicker(d time.Duration) Ticker {
+	if d <= 0 {
+		panic("golali: non-positive interval for ManualClock.NewTicker")
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	w := &manualWaiter{clock: c, c: make(chan time.Time, 1), period: d}
+	c.schedule(w, d)
+	return manualTicker{w}
+}
+
+// schedule arms w to fire d after the current time. A timer that is
+// already due fires at once. c.mu must be held.
+func (c *ManualClock) schedule(w *manualWaiter, d time.Duration) {
+	w.when = c.now.Add(d)
+	c.waiters = append(c.waiters, w)
+	if d <= 0 && w.period == 0 {
+		c.moveTo(c.now)
+	}
+}
+
+// remove disarms w and reports whether it was armed. c.mu must be held.
+func (c *ManualClock) remove(w *manualWaiter) bool {
+	for i, x := range c.waiters {
+		if x == w {
+			c.waiters = append(c.waiters[:i], c.waiters[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// manualWaiter is a timer, or with a period a ticker, of a ManualClock.
+type manualWaiter struct {
+	clock  *ManualClock
+	c      chan time.Time
+	when   time.Time
+	period time.Duration
+}
+
+func (w *manualWaiter) C() <-chan time.Time { return w.c }
+
+// Stop prevents the timer from firing. It reports whether the call stopped
+// the timer, as time.Timer.Stop does.
+func (w *manualWaiter) Stop() bool {
+	w.clock.mu.Lock()
+	defer w.clock.mu.Unlock()
+	return w.clock.remove(w)
+}
+
+// Reset changes the timer to fire d after the clock's current time. It
+// reports whether the timer had been active.
+func (w *manualWaiter) Reset(d time.Duration) bool {
+	w.clock.mu.Lock()
+	defer w.clock.mu.Unlock()
+	active := w.clock.remove(w)
+	w.clock.schedule(w, d)
+	return active
+}
+
+type manualTicker struct{ w *manualWaiter }
+
+func (t manualTicker) C() <-chan time.Time { return t.w.c }
+func (t manualTicker) Stop()               { t.w.Stop() }
+
+// Reset stops the ticker and restarts it with period d. It panics if d <= 0.
+func (t manualTicker) Reset(d time.Duration) {
+	if d <= 0 {
+		panic("golali: non-positive interval for ManualClock ticker Reset")
+	}
+	t.w.clock.mu.Lock()
+	defer t.w.clock.mu.Unlock()
+	t.w.clock.remove(t.w)
+	t.w.period = d
+	t.w.clock.schedule(t.w, d)
+}
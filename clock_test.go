@@ -0,0 +1,143 @@
+package golali_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bijanghanei/golali"
+)
+
+func TestManualClock(t *testing.T) {
+	start := time.Date(2025, time.March, 20, 0, 0, 0, 0, time.UTC)
+	clock := golali.NewManualClock(start)
+	if got := golali.NowFrom(clock); got.String() != "1403/12/30 00:00:00" {
+		t.Errorf("NowFrom = %v", got)
+	}
+
+	timer := clock.NewTimer(90 * time.Minute)
+	after := clock.After(30 * time.Minute)
+	ticker := clock.NewTicker(time.Hour)
+
+	clock.Advance(45 * time.Minute)
+	select {
+	case got := <-after:
+		if !got.Equal(start.Add(30 * time.Minute)) {
+			t.Errorf("After fired at %v", got)
+		}
+	default:
+		t.Fatal("After did not fire")
+	}
+	select {
+	case <-timer.C():
+		t.Fatal("timer fired early")
+	case <-ticker.C():
+		t.Fatal("ticker fired early")
+	default:
+	}
+
+	clock.Advance(time.Hour)
+	if got := <-timer.C(); !got.Equal(start.Add(90 * time.Minute)) {
+		t.Errorf("timer fired at %v", got)
+	}
+	if got := <-ticker.C(); !got.Equal(start.Add(time.Hour)) {
+		t.Errorf("ticker fired at %v", got)
+	}
+	if timer.Stop() {
+		t.Error("Stop of a fired timer reported true")
+	}
+
+	// The ticker keeps one pending tick and drops the rest.
+	clock.Advance(3 * time.Hour)
+	if got := <-ticker.C(); !got.Equal(start.Add(2 * time.Hour)) {
+		t.Errorf("ticker fired at %v", got)
+	}
+	select {
+	case got := <-ticker.C():
+		t.Errorf("extra tick at %v", got)
+	default:
+	}
+	if !clock.Now().Equal(start.Add(285 * time.Minute)) {
+		t.Errorf("Now() = %v", clock.Now())
+	}
+
+	ticker.Stop()
+	clock.Advance(2 * time.Hour)
+	select {
+	case <-ticker.C():
+		t.Error("stopped ticker fired")
+	default:
+	}
+}
+
+func TestManualClockSetAndReset(t *testing.T) {
+	start := time.Date(2025, time.March, 20, 0, 0, 0, 0, time.UTC)
+	clock := golali.NewManualClock(start)
+	timer := clock.NewTimer(time.Hour)
+	if !timer.Reset(2 * time.Hour) {
+		t.Error("Reset of an active timer reported false")
+	}
+	clock.Set(start.Add(90 * time.Minute))
+	select {
+	case <-timer.C():
+		t.Fatal("reset timer fired at its old deadline")
+	default:
+	}
+	clock.Set(start.Add(3 * time.Hour))
+	if got := <-timer.C(); !got.Equal(start.Add(2 * time.Hour)) {
+		t.Errorf("timer fired at %v", got)
+	}
+
+	// A timer that is already due fires at once.
+	if got := <-clock.After(0); !got.Equal(clock.Now()) {
+		t.Errorf("After(0) = %v", got)
+	}
+
+	// Month-end jobs can be driven across the Jalali new year.
+	ticker := clock.NewTicker(24 * time.Hour)
+	defer ticker.Stop()
+	clock.Set(time.Date(2025, time.March, 21, 3, 0, 0, 0, time.UTC))
+	if got := golali.ToJalaliDateTime(<-ticker.C()); got.Year() != 1404 || got.Month() != golali.Farvardin {
+		t.Errorf("tick at %v", got)
+	}
+}
+
+func TestManualClockLongAdvance(t *testing.T) {
+	start := time.Date(2025, time.March, 20, 0, 0, 0, 0, time.UTC)
+	clock := golali.NewManualClock(start)
+	ticker := clock.NewTicker(time.Millisecond)
+	defer ticker.Stop()
+	timer := clock.NewTimer(90 * time.Minute)
+
+	// A month of millisecond ticks is skipped over, not walked.
+	begin := time.Now()
+	clock.Set(start.AddDate(0, 1, 0))
+	if d := time.Since(begin); d > time.Second {
+		t.Errorf("Set across a month of ticks took %v", d)
+	}
+	if got := <-ticker.C(); !got.Equal(start.Add(time.Millisecond)) {
+		t.Errorf("ticker fired at %v", got)
+	}
+	if got := <-timer.C(); !got.Equal(start.Add(90 * time.Minute)) {
+		t.Errorf("timer fired at %v", got)
+	}
+
+	// The next tick is the first one after the new time.
+	clock.Advance(1500 * time.Microsecond)
+	if got := <-ticker.C(); !got.Equal(start.AddDate(0, 1, 0).Add(time.Millisecond)) {
+		t.Errorf("ticker fired at %v", got)
+	}
+}
+
+func TestSystemClock(t *testing.T) {
+	before := time.Now()
+	now := golali.NowFrom(golali.SystemClock)
+	if now.ToTime().Before(before) || now.ToTime().After(time.Now()) {
+		t.Errorf("NowFrom(SystemClock) = %v", now)
+	}
+	timer := golali.SystemClock.NewTimer(time.Millisecond)
+	<-timer.C()
+	ticker := golali.SystemClock.NewTicker(time.Millisecond)
+	<-ticker.C()
+	ticker.Stop()
+	<-golali.SystemClock.After(time.Millisecond)
+}
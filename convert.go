@@ -11,18 +11,18 @@ func gregorianToJalali(gYear int, gMonth time.Month, gDay int) (jYear int, jMont
 	gm := int(gMonth) - 1
 	gd := gDay - 1
 
-	gDayNo := 365*gy + (gy+3)/4 - (gy+99)/100 + (gy+399)/400
+	gDayNo := 365*gy + floorDiv(gy+3, 4) - floorDiv(gy+99, 100) + floorDiv(gy+399, 400)
 	for i := 0; i < gm; i++ {
 		gDayNo += gregorianDaysInMonth[i]
 	}
-	if gm > 1 && ((gy%4 == 0 && gy%100 != 0) || (gy%400 == 0)) {
+	if gm > 1 && ((floorMod(gy, 4) == 0 && floorMod(gy, 100) != 0) || floorMod(gy, 400) == 0) {
 		gDayNo++
 	}
 	gDayNo += gd
 
 	jDayNo := gDayNo - 79
-	jNp := jDayNo / 12053
-	jDayNo %= 12053
+	jNp := floorDiv(jDayNo, 12053)
+	jDayNo = floorMod(jDayNo, 12053)
 
 	jYear = 979 + 33*jNp + 4*(jDayNo/1461)
 	jDayNo %= 1461
@@ -42,21 +42,36 @@ func gregorianToJalali(gYear int, gMonth time.Month, gDay int) (jYear int, jMont
 	return
 }
 
+// floorDiv returns a/b rounded down, for b > 0. The conversions count days
+// from 1600 and 979, and need it for the years before.
+func floorDiv[T int | int64](a, b T) T {
+	q := a / b
+	if a%b < 0 {
+		q--
+	}
+	return q
+}
+
+// floorMod returns a - b*floorDiv(a, b), which is never negative.
+func floorMod[T int | int64](a, b T) T {
+	return a - b*floorDiv(a, b)
+}
+
 // jalaliToGregorian converts a Jalali date to a Gregorian date
 func jalaliToGregorian(jYear int, jMonth int, jDay int) (gYear int, gMonth int, gDay int) {
 	jy := jYear - 979
 	jm := jMonth - 1
 	jd := jDay - 1
 
-	jDayNo := 365*jy + (jy/33)*8 + (jy%33+3)/4
+	jDayNo := 365*jy + floorDiv(jy, 33)*8 + (floorMod(jy, 33)+3)/4
 	for i := 0; i < jm; i++ {
 		jDayNo += jalaliDaysInMonth[i]
 	}
 	jDayNo += jd
 
 	gDayNo := jDayNo + 79
-	gy := 1600 + 400*(gDayNo/146097)
-	gDayNo %= 146097
+	gy := 1600 + 400*floorDiv(gDayNo, 146097)
+	gDayNo = floorMod(gDayNo, 146097)
 
 	leap := true
 	if gDayNo >= 36525 {
@@ -91,10 +106,25 @@ func jalaliToGregorian(jYear int, jMonth int, jDay int) (gYear int, gMonth int,
 	return gy, gMonth, gDay
 }
 
-// ToJalaliDateTime converts time.Time to JalaliDateTime
+// jalaliDayNumber numbers a Jalali date so that consecutive dates have
+// consecutive numbers; 1970-01-01 is day zero.
+func jalaliDayNumber(year int, month Month, day int) int64 {
+	gYear, gMonth, gDay := jalaliToGregorian(year, int(month), day)
+	return time.Date(gYear, time.Month(gMonth), gDay, 0, 0, 0, 0, time.UTC).Unix() / 86400
+}
+
+// jalaliFromDayNumber is the inverse of jalaliDayNumber.
+func jalaliFromDayNumber(n int64) (int, Month, int) {
+	t := time.Unix(n*86400, 0).UTC()
+	return gregorianToJalali(t.Year(), t.Month(), t.Day())
+}
+
+// ToJalaliDateTime converts time.Time to JalaliDateTime, keeping any
+// monotonic clock reading of t.
 func ToJalaliDateTime(t time.Time) JalaliDateTime {
 	jYear, jMonth, jDay := gregorianToJalali(t.Year(), t.Month(), t.Day())
 	return JalaliDateTime{
+		t:        t,
 		year:     jYear,
 		month:    jMonth,
 		day:      jDay,
@@ -106,16 +136,18 @@ func ToJalaliDateTime(t time.Time) JalaliDateTime {
 	}
 }
 
-// ToTime converts JalaliDateTime to time.Time
+// ToTime converts JalaliDateTime to time.Time. A wall time that occurs
+// twice maps to the earlier instant unless j came from the later one.
+// Date and the other constructors move a wall time skipped by a daylight
+// saving transition forward by the length of the gap, so the fields of j
+// already name the time that is returned; see Resolve for other choices.
 func (j JalaliDateTime) ToTime() time.Time {
-	if j.location == nil {
-		j.location = time.Local
-	}
-	gYear, gMonth, gDay := jalaliToGregorian(j.year, int(j.month), j.day)
-	return time.Date(gYear, time.Month(gMonth), gDay, j.hour, j.min, j.sec, j.nanosec, j.location)
+	return j.t
 }
 
-// Now returns the current JalaliDateTime.
+// Now returns the current JalaliDateTime. The result keeps the monotonic
+// clock reading of time.Now for Sub, Since and Until; In, UTC, Local and
+// the calendar arithmetic methods drop it, as their time.Time counterparts do.
 func Now() JalaliDateTime {
 	return ToJalaliDateTime(time.Now())
 }
@@ -143,8 +175,13 @@ func Date(year int, month Month, day, hour, min, sec, nsec int, loc *time.Locati
 	if nsec < 0 || nsec > 999999999 {
 		panic(fmt.Sprintf("nanosecond out of range: %d", nsec))
 	}
+	return newJalali(year, month, day, hour, min, sec, nsec, loc)
+}
 
-	return JalaliDateTime{
+// newJalali builds a JalaliDateTime from valid wall clock fields, resolving
+// the instant as ToTime documents.
+func newJalali(year int, month Month, day, hour, min, sec, nsec int, loc *time.Location) JalaliDateTime {
+	j := JalaliDateTime{
 		year:     year,
 		month:    month,
 		day:      day,
@@ -154,6 +191,15 @@ func Date(year int, month Month, day, hour, min, sec, nsec int, loc *time.Locati
 		nanosec:  nsec,
 		location: loc,
 	}
+	w := j.wallTimes()
+	j.t = w.earlier
+	if w.gap {
+		// Report the wall clock of the instant, which lies past the gap.
+		j = ToJalaliDateTime(w.later)
+		j.location = loc
+		j.gap = w.later.Sub(w.earlier)
+	}
+	return j
 }
 
 func boolToInt(b bool) int {
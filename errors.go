@@ -0,0 +1,69 @@
+package golali
+
+import (
+	"strconv"
+	"unicode/utf8"
+)
+
+// ParseErrorKind classifies the reason a value could not be parsed.
+type ParseErrorKind int
+
+const (
+	// Mismatch means the value does not follow the structure of the layout.
+	Mismatch ParseErrorKind = iota + 1
+	// OutOfRange means a field was read but its value is not valid.
+	OutOfRange
+	// UnknownElement means the layout contains an element the parser does not understand.
+	UnknownElement
+	// Ambiguous means the value matches several layouts that disagree on the date.
+	Ambiguous
+)
+
+// String returns the name of the kind.
+func (k ParseErrorKind) String() string {
+	switch k {
+	case Mismatch:
+		return "Mismatch"
+	case OutOfRange:
+		return "OutOfRange"
+	case UnknownElement:
+		return "UnknownElement"
+	case Ambiguous:
+		return "Ambiguous"
+	default:
+		return "ParseErrorKind(" + strconv.Itoa(int(k)) + ")"
+	}
+}
+
+// ParseError describes a problem parsing a Jalali date string.
+// It mirrors time.ParseError and can be retrieved with errors.As.
+type ParseError struct {
+	Layout     string         // the layout passed to the parser
+	Value      string         // the value passed to the parser
+	LayoutElem string         // the layout element being processed
+	ValueElem  string         // the part of the value that failed
+	Offset     int            // byte offset of ValueElem within Value
+	Kind       ParseErrorKind // the class of the failure
+	Message    string         // optional description replacing the default one
+}
+
+// Error returns the string representation of a ParseError.
+func (e *ParseError) Error() string {
+	if e.Message == "" {
+		return "parsing time " + strconv.Quote(e.Value) + " as " + strconv.Quote(e.Layout) +
+			": cannot parse " + strconv.Quote(e.ValueElem) + " as " + strconv.Quote(e.LayoutElem)
+	}
+	return "parsing time " + strconv.Quote(e.Value) + ": " + e.Message
+}
+
+// RuneOffset returns the offset of ValueElem within Value counted in runes,
+// which is what a user sees when the value contains Persian text.
+func (e *ParseError) RuneOffset() int {
+	if e.Offset <= 0 {
+		return 0
+	}
+	if e.Offset > len(e.Value) {
+		return utf8.RuneCountInString(e.Value)
+	}
+	return utf8.RuneCountInString(e.Value[:e.Offset])
+}
@@ -2,15 +2,48 @@ package golali_test
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/bijanghanei/golali"
 )
 
 func ExampleNow() {
+	// The output depends on the current time, so this example is not run;
+	// see ExampleNowFrom for a reproducible version.
 	now := golali.Now()
-	fmt.Println(now.Format("%Y/%m/%d %R"))
+	fmt.Println(now.Format("%Y/%m/%d %R")) // e.g. 1404/09/27 12:34
+}
+
+func ExampleNowFrom() {
+	clock := golali.NewManualClock(time.Date(2025, time.December, 18, 12, 34, 0, 0, golali.IRST()))
+	fmt.Println(golali.NowFrom(clock).Format("%Y/%m/%d %R"))
+	clock.Advance(24 * time.Hour)
+	fmt.Println(golali.NowFrom(clock).Format("%Y/%m/%d %R"))
+	// Output:
+	// 1404/09/27 12:34
+	// 1404/09/28 12:34
+}
+
+func ExampleManualClock() {
+	clock := golali.NewManualClock(time.Date(2025, time.March, 19, 23, 0, 0, 0, golali.IRST()))
+	ticker := clock.NewTicker(time.Hour)
+	defer ticker.Stop()
+
+	clock.Advance(time.Hour)
+	fmt.Println(golali.ToJalaliDateTime(<-ticker.C()).Format("%Y/%m/%d %R"))
+	// Output:
+	// 1403/12/30 00:00
+}
+
+func ExampleJalaliDateTime_Hijri() {
+	j := golali.Date(1403, golali.Mehr, 15, 0, 0, 0, 0, golali.IRST())
+	fmt.Println(j.Hijri(golali.HijriIran), j.Hijri(golali.HijriIran).Month)
+
+	opts := golali.FormatOptions{Digits: golali.PersianDigits, Hijri: golali.HijriIran}
+	fmt.Println(j.FormatWith("%-d %B %Y / %-Qd %QB %QY", opts))
 	// Output:
-	// 1404/09/27 12:34 (example; actual depends on current time)
+	// 1446/04/02 Rabi al-Thani
+	// ۱۵ مهر ۱۴۰۳ / ۲ ربیع‌الثانی ۱۴۴۶
 }
 
 func ExampleDate() {
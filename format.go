@@ -1,85 +1,537 @@
 package golali
 
 import (
-	"fmt"
-	"strings"
+	"time"
+	"unicode/utf8"
 )
 
+// DigitSystem selects the digits used for numbers in formatted output.
+type DigitSystem int
+
+const (
+	// ASCIIDigits writes 0123456789.
+	ASCIIDigits DigitSystem = iota
+	// PersianDigits writes ۰۱۲۳۴۵۶۷۸۹ (Extended Arabic-Indic).
+	PersianDigits
+	// ArabicDigits writes ٠١٢٣٤٥٦٧٨٩ (Arabic-Indic).
+	ArabicDigits
+)
+
+// zero returns the digit zero of the system.
+func (d DigitSystem) zero() rune {
+	switch d {
+	case PersianDigits:
+		return '۰'
+	case ArabicDigits:
+		return '٠'
+	default:
+		return '0'
+	}
+}
+
+// FormatOptions controls optional behaviour of FormatWith.
+// The zero value formats exactly like Format.
+type FormatOptions struct {
+	// Digits is the digit system used by numeric specifiers,
+	// including the offset of %z and the clock of %R and %T.
+	Digits DigitSystem
+
+	// Locale supplies the names written by %B, %b, %w, %a and %p.
+	// Nil keeps the package defaults. The locale's own digit system is
+	// only applied by FormatLocale; here Digits decides.
+	Locale *Locale
+
+	// Hijri selects the lunar Hijri calendar written by the %Q specifiers.
+	Hijri HijriConvention
+}
+
 // Format returns a formatted string according to the layout.
 func (j JalaliDateTime) Format(layout string) string {
-	var builder strings.Builder
-	length := len(layout)
-	i := 0
-
-	for i < length {
-		if layout[i] == '%' && i+1 < length {
-			specifier := layout[i : i+2]
-			switch specifier {
-			case "%n":
-				builder.WriteByte('\n')
-			case "%%":
-				builder.WriteByte('%')
-			case "%Y":
-				builder.WriteString(fmt.Sprintf("%04d", j.year))
-			case "%y":
-				builder.WriteString(fmt.Sprintf("%02d", j.year%100))
-			case "%m":
-				builder.WriteString(fmt.Sprintf("%02d", j.month))
-			case "%B":
-				builder.WriteString(FaJalaliMonthName[j.month])
-			case "%b":
-				name := FaJalaliMonthName[j.month]
-				if len(name) >= 3 {
-					s := string([]rune(name)[:3])
-					builder.WriteString(s)
-				} else {
-					builder.WriteString(name)
-				}
-			case "%d":
-				builder.WriteString(fmt.Sprintf("%02d", j.day))
-			case "%H":
-				builder.WriteString(fmt.Sprintf("%02d", j.hour))
-			case "%M":
-				builder.WriteString(fmt.Sprintf("%02d", j.min))
-			case "%S":
-				builder.WriteString(fmt.Sprintf("%02d", j.sec))
-			case "%p":
-				if j.hour < 12 {
-					builder.WriteString("صبح")
-				} else {
-					builder.WriteString("عصر")
-				}
-			case "%w":
-				builder.WriteString(FaWeekDays[j.Weekday()])
-			case "%z":
-				_, offset := j.Zone()
-				sign := "+"
-				if offset < 0 {
-					sign = "-"
-					offset = -offset
-				}
-				hours := offset / 3600
-				minutes := (offset % 3600) / 60
-				builder.WriteString(fmt.Sprintf("%s%02d%02d", sign, hours, minutes))
-			case "%Z":
-				if j.location != nil {
-					builder.WriteString(j.location.String())
-				}
-			case "%R":
-				builder.WriteString(fmt.Sprintf("%02d:%02d", j.hour, j.min))
-			case "%T":
-				builder.WriteString(fmt.Sprintf("%02d:%02d:%02d", j.hour, j.min, j.sec))
-			default:
-				builder.WriteString(specifier)
-			}
-			i += 2
+	return j.FormatWith(layout, FormatOptions{})
+}
+
+// FormatWith is like Format but applies the given options.
+func (j JalaliDateTime) FormatWith(layout string, opts FormatOptions) string {
+	var buf [64]byte
+	return string(j.AppendFormatWith(buf[:0], layout, opts))
+}
+
+// AppendFormat is like Format but appends the textual representation to b
+// and returns the extended buffer. It does not allocate when b has enough
+// capacity.
+func (j JalaliDateTime) AppendFormat(b []byte, layout string) []byte {
+	return j.AppendFormatWith(b, layout, FormatOptions{})
+}
+
+// AppendFormatWith is like AppendFormat but applies the given options.
+func (j JalaliDateTime) AppendFormatWith(b []byte, layout string, opts FormatOptions) []byte {
+	for i := 0; i < len(layout); i++ {
+		if layout[i] != '%' || i+1 == len(layout) {
+			b = append(b, layout[i])
+			continue
+		}
+		n, code, f := scanSpec(layout[i:])
+		b = j.appendSpec(b, code, layout[i:i+n], f, opts)
+		i += n - 1
+	}
+	return b
+}
+
+// Layout is a format layout parsed once by CompileLayout, so that it can be
+// applied many times without scanning the layout again.
+// A Layout is safe for concurrent use.
+type Layout struct {
+	layout string
+	elems  []layoutSpec
+}
+
+// layoutSpec is a compiled piece of a Layout: literal text or a specifier.
+type layoutSpec struct {
+	code  specCode  // specLiteral for literal text
+	text  string    // the literal text or the specifier as written
+	flags specFlags // padding, width and case of a specifier
+}
+
+// CompileLayout parses a Format layout into a reusable Layout.
+func CompileLayout(layout string) *Layout {
+	l := &Layout{layout: layout}
+	start := 0
+	for i := 0; i < len(layout); i++ {
+		if layout[i] != '%' || i+1 == len(layout) {
+			continue
+		}
+		if i > start {
+			l.elems = append(l.elems, layoutSpec{code: specLiteral, text: layout[start:i]})
+		}
+		n, code, f := scanSpec(layout[i:])
+		l.elems = append(l.elems, layoutSpec{code: code, text: layout[i : i+n], flags: f})
+		i += n - 1
+		start = i + 1
+	}
+	if start < len(layout) {
+		l.elems = append(l.elems, layoutSpec{code: specLiteral, text: layout[start:]})
+	}
+	return l
+}
+
+// String returns the layout as passed to CompileLayout.
+func (l *Layout) String() string {
+	return l.layout
+}
+
+// Format formats j according to the layout.
+func (l *Layout) Format(j JalaliDateTime) string {
+	var buf [64]byte
+	return string(l.AppendFormatWith(buf[:0], j, FormatOptions{}))
+}
+
+// AppendFormat appends j formatted according to the layout to b.
+func (l *Layout) AppendFormat(b []byte, j JalaliDateTime) []byte {
+	return l.AppendFormatWith(b, j, FormatOptions{})
+}
+
+// AppendFormatWith is like AppendFormat but applies the given options.
+func (l *Layout) AppendFormatWith(b []byte, j JalaliDateTime, opts FormatOptions) []byte {
+	for _, e := range l.elems {
+		if e.code == specLiteral {
+			b = append(b, e.text...)
+			continue
+		}
+		b = j.appendSpec(b, e.code, e.text, e.flags, opts)
+	}
+	return b
+}
+
+// specCode identifies a format specifier.
+type specCode uint8
+
+const (
+	specLiteral specCode = iota
+	specUnknown
+	specNewline
+	specPercent
+	specYear
+	specYear2
+	specMonth
+	specMonthName
+	specMonthAbbr
+	specEnMonthName
+	specEnMonthAbbr
+	specDay
+	specHour
+	specMinute
+	specSecond
+	specDayPeriod
+	specWeekday
+	specWeekdayAbbr
+	specFinglishWeekday
+	specEnWeekday
+	specEnWeekdayAbbr
+	specZoneOffset
+	specZoneName
+	specClock
+	specClockSeconds
+	specDaySpace
+	specYearDay
+	specWeekdayNumber
+	specUnix
+	specDayWords
+	specYearWords
+	specHijriYear
+	specHijriMonth
+	specHijriDay
+	specHijriDaySpace
+	specHijriMonthName
+	specHijriArMonthName
+)
+
+// specFlags holds the GNU-style flags and width written between '%' and the
+// conversion character, as in %-d, %_5H or %^B.
+type specFlags struct {
+	pad   byte // '-' for no padding, '_' for spaces, '0' for zeros, 0 for the default
+	upper bool // '^': write letters in upper case
+	width int  // minimum field width, 0 for the default
+}
+
+// maxSpecWidth bounds explicit widths so that a layout cannot request an
+// arbitrarily large output.
+const maxSpecWidth = 256
+
+// scanSpec reads the specifier at the start of s, which begins with '%'.
+// It returns the length of the specifier in bytes, its code and its flags.
+// A specifier is '%', optional flags, an optional width, an optional E, O or
+// Q modifier and a conversion character.
+func scanSpec(s string) (n int, code specCode, f specFlags) {
+	i := 1
+flags:
+	for ; i < len(s); i++ {
+		switch s[i] {
+		case '-', '_', '0':
+			f.pad = s[i]
+		case '^':
+			f.upper = true
+		default:
+			break flags
+		}
+	}
+	for ; i < len(s) && s[i] >= '0' && s[i] <= '9'; i++ {
+		if f.width < maxSpecWidth {
+			f.width = f.width*10 + int(s[i]-'0')
+		}
+	}
+	if f.width > maxSpecWidth {
+		f.width = maxSpecWidth
+	}
+	var mod byte
+	if i+1 < len(s) && (s[i] == 'E' || s[i] == 'O' || s[i] == 'Q') {
+		mod = s[i]
+		i++
+	}
+	if i == len(s) {
+		return i, specUnknown, f
+	}
+	return i + 1, lookupSpec(mod, s[i]), f
+}
+
+// lookupSpec returns the code of the conversion character c with the
+// modifier mod, which is 0, 'E', 'O' or 'Q'.
+func lookupSpec(mod, c byte) specCode {
+	if mod == 'Q' {
+		switch c {
+		case 'Y':
+			return specHijriYear
+		case 'm':
+			return specHijriMonth
+		case 'd':
+			return specHijriDay
+		case 'e':
+			return specHijriDaySpace
+		case 'B':
+			return specHijriMonthName
+		case 'A':
+			return specHijriArMonthName
+		default:
+			return specUnknown
+		}
+	}
+	if mod == 'O' {
+		switch c {
+		case 'd':
+			return specDayWords
+		case 'Y':
+			return specYearWords
+		default:
+			return specUnknown
+		}
+	}
+	if mod == 'E' {
+		switch c {
+		case 'B':
+			return specEnMonthName
+		case 'b':
+			return specEnMonthAbbr
+		case 'w':
+			return specFinglishWeekday
+		case 'A':
+			return specEnWeekday
+		case 'a':
+			return specEnWeekdayAbbr
+		default:
+			return specUnknown
+		}
+	}
+	switch c {
+	case 'n':
+		return specNewline
+	case '%':
+		return specPercent
+	case 'Y':
+		return specYear
+	case 'y':
+		return specYear2
+	case 'm':
+		return specMonth
+	case 'B':
+		return specMonthName
+	case 'b':
+		return specMonthAbbr
+	case 'd':
+		return specDay
+	case 'e':
+		return specDaySpace
+	case 'j':
+		return specYearDay
+	case 'H':
+		return specHour
+	case 'M':
+		return specMinute
+	case 'S':
+		return specSecond
+	case 'p':
+		return specDayPeriod
+	case 'w':
+		return specWeekday
+	case 'a':
+		return specWeekdayAbbr
+	case 'u':
+		return specWeekdayNumber
+	case 's':
+		return specUnix
+	case 'z':
+		return specZoneOffset
+	case 'Z':
+		return specZoneName
+	case 'R':
+		return specClock
+	case 'T':
+		return specClockSeconds
+	default:
+		return specUnknown
+	}
+}
+
+// appendSpec appends the value of one specifier. Unknown specifiers are
+// copied to the output unchanged.
+func (j JalaliDateTime) appendSpec(b []byte, code specCode, spec string, f specFlags, opts FormatOptions) []byte {
+	d := opts.Digits
+	switch code {
+	case specUnknown:
+		return append(b, spec...)
+	case specNewline:
+		return append(b, '\n')
+	case specPercent:
+		return append(b, '%')
+	case specYear:
+		return appendNum(b, int64(j.year), 4, '0', f, d)
+	case specYear2:
+		return appendNum(b, int64(j.year%100), 2, '0', f, d)
+	case specMonth:
+		return appendNum(b, int64(j.month), 2, '0', f, d)
+	case specDay:
+		return appendNum(b, int64(j.day), 2, '0', f, d)
+	case specDaySpace:
+		return appendNum(b, int64(j.day), 2, ' ', f, d)
+	case specYearDay:
+		return appendNum(b, int64(j.YearDay()), 3, '0', f, d)
+	case specHour:
+		return appendNum(b, int64(j.hour), 2, '0', f, d)
+	case specMinute:
+		return appendNum(b, int64(j.min), 2, '0', f, d)
+	case specSecond:
+		return appendNum(b, int64(j.sec), 2, '0', f, d)
+	case specWeekdayNumber:
+		// Shanbe is the first day of the Persian week.
+		return appendNum(b, int64(j.Weekday()+1)%7+1, 1, '0', f, d)
+	case specUnix:
+		return appendNum(b, j.Unix(), 0, '0', f, d)
+	case specHijriYear:
+		return appendNum(b, int64(j.Hijri(opts.Hijri).Year), 4, '0', f, d)
+	case specHijriMonth:
+		return appendNum(b, int64(j.Hijri(opts.Hijri).Month), 2, '0', f, d)
+	case specHijriDay:
+		return appendNum(b, int64(j.Hijri(opts.Hijri).Day), 2, '0', f, d)
+	case specHijriDaySpace:
+		return appendNum(b, int64(j.Hijri(opts.Hijri).Day), 2, ' ', f, d)
+	}
+	start := len(b)
+	b = j.appendText(b, code, d, opts)
+	return padText(b, start, f)
+}
+
+// appendText appends the value of a specifier that is not a single number.
+func (j JalaliDateTime) appendText(b []byte, code specCode, d DigitSystem, opts FormatOptions) []byte {
+	l := opts.Locale
+	switch code {
+	case specMonthName:
+		return append(b, l.MonthName(j.month)...)
+	case specMonthAbbr:
+		return append(b, l.ShortMonthName(j.month)...)
+	case specEnMonthName:
+		return append(b, EnJalaliMonthName[j.month]...)
+	case specEnMonthAbbr:
+		return append(b, abbreviate(EnJalaliMonthName[j.month])...)
+	case specDayPeriod:
+		return append(b, l.dayPeriod(j.hour)...)
+	case specWeekday:
+		return append(b, l.WeekdayName(j.Weekday())...)
+	case specWeekdayAbbr:
+		return append(b, l.ShortWeekdayName(j.Weekday())...)
+	case specFinglishWeekday:
+		return append(b, FinglishWeekDays[j.Weekday()]...)
+	case specEnWeekday:
+		return append(b, time.Weekday(j.Weekday()).String()...)
+	case specEnWeekdayAbbr:
+		return append(b, time.Weekday(j.Weekday()).String()[:3]...)
+	case specZoneOffset:
+		_, offset := j.Zone()
+		if offset < 0 {
+			b = append(b, '-')
+			offset = -offset
 		} else {
-			builder.WriteByte(layout[i])
-			i++
+			b = append(b, '+')
+		}
+		b = appendInt(b, int64(offset/3600), 2, '0', d)
+		return appendInt(b, int64((offset%3600)/60), 2, '0', d)
+	case specDayWords:
+		return appendOrdinalWords(b, j.day, true)
+	case specYearWords:
+		return appendWords(b, j.year, true)
+	case specHijriMonthName:
+		return append(b, hijriMonthName(FaHijriMonthName, j.Hijri(opts.Hijri).Month)...)
+	case specHijriArMonthName:
+		return append(b, hijriMonthName(ArHijriMonthName, j.Hijri(opts.Hijri).Month)...)
+	case specZoneName:
+		if j.location != nil {
+			b = append(b, j.location.String()...)
+		}
+		return b
+	case specClock:
+		b = appendInt(b, int64(j.hour), 2, '0', d)
+		b = append(b, ':')
+		return appendInt(b, int64(j.min), 2, '0', d)
+	case specClockSeconds:
+		b = appendInt(b, int64(j.hour), 2, '0', d)
+		b = append(b, ':')
+		b = appendInt(b, int64(j.min), 2, '0', d)
+		b = append(b, ':')
+		return appendInt(b, int64(j.sec), 2, '0', d)
+	}
+	return b
+}
+
+// appendNum appends a numeric field. width and pad are the defaults of the
+// specifier, which the flags may override.
+func appendNum(b []byte, n int64, width int, pad byte, f specFlags, d DigitSystem) []byte {
+	if f.width > 0 {
+		width = f.width
+	}
+	switch f.pad {
+	case '-':
+		width = 0
+	case '_':
+		pad = ' '
+	case '0':
+		pad = '0'
+	}
+	return appendInt(b, n, width, pad, d)
+}
+
+// padText applies the flags to the text appended to b after start: '^'
+// upper-cases Latin letters and a width pads on the left with spaces, or
+// zeros with the '0' flag.
+func padText(b []byte, start int, f specFlags) []byte {
+	if f.upper {
+		for i := start; i < len(b); i++ {
+			if 'a' <= b[i] && b[i] <= 'z' {
+				b[i] -= 'a' - 'A'
+			}
 		}
 	}
+	if f.width == 0 || f.pad == '-' {
+		return b
+	}
+	n := f.width - utf8.RuneCount(b[start:])
+	if n <= 0 {
+		return b
+	}
+	pad := byte(' ')
+	if f.pad == '0' {
+		pad = '0'
+	}
+	for i := 0; i < n; i++ {
+		b = append(b, pad)
+	}
+	copy(b[start+n:], b[start:len(b)-n])
+	for i := start; i < start+n; i++ {
+		b[i] = pad
+	}
+	return b
+}
+
+// appendInt appends n padded to width with pad, which is '0' or ' ', writing
+// digits and zero padding in the digit system.
+func appendInt(b []byte, n int64, width int, pad byte, d DigitSystem) []byte {
+	u := uint64(n)
+	if n < 0 {
+		u = -u
+	}
+	var buf [20]byte
+	i := len(buf)
+	for u >= 10 {
+		i--
+		buf[i] = byte(u % 10)
+		u /= 10
+	}
+	i--
+	buf[i] = byte(u)
 
-	return builder.String()
+	size := len(buf) - i
+	if n < 0 {
+		size++
+	}
+	if pad == ' ' {
+		for ; size < width; size++ {
+			b = append(b, ' ')
+		}
+	}
+	if n < 0 {
+		b = append(b, '-')
+	}
+	for ; size < width; size++ {
+		b = appendDigit(b, 0, d)
+	}
+	for _, v := range buf[i:] {
+		b = appendDigit(b, v, d)
+	}
+	return b
+}
+
+// appendDigit appends the digit v in the digit system.
+func appendDigit(b []byte, v byte, d DigitSystem) []byte {
+	if d == ASCIIDigits {
+		return append(b, '0'+v)
+	}
+	return utf8.AppendRune(b, d.zero()+rune(v))
 }
 
 // FormatDateTime returns formatted date time as %Y/%m/%d %R
@@ -87,7 +539,17 @@ func (jdt JalaliDateTime) FormatDateTime() string {
 	return jdt.Format("%Y/%m/%d %R")
 }
 
+// FormatDateTimeWith is like FormatDateTime but applies the given options.
+func (jdt JalaliDateTime) FormatDateTimeWith(opts FormatOptions) string {
+	return jdt.FormatWith("%Y/%m/%d %R", opts)
+}
+
 // String returns formatted as %Y/%m/%d %T
 func (jdt JalaliDateTime) String() string {
 	return jdt.Format("%Y/%m/%d %T")
-}
\ No newline at end of file
+}
+
+// StringWith is like String but applies the given options.
+func (jdt JalaliDateTime) StringWith(opts FormatOptions) string {
+	return jdt.FormatWith("%Y/%m/%d %T", opts)
+}
@@ -2,6 +2,7 @@ package golali_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/bijanghanei/golali"
 )
@@ -40,4 +41,187 @@ func TestFormat(t *testing.T) {
 	if got := j.FormatDateTime(); got != "1403/03/05 14:30" {
 		t.Errorf("FormatDateTime() = %q", got)
 	}
-}
\ No newline at end of file
+}
+
+func TestFormatWithDigits(t *testing.T) {
+	j := golali.Date(1403, golali.Mehr, 5, 9, 7, 3, 0, time.FixedZone("", 12600))
+	persian := golali.FormatOptions{Digits: golali.PersianDigits}
+	arabic := golali.FormatOptions{Digits: golali.ArabicDigits}
+
+	tests := []struct {
+		layout string
+		opts   golali.FormatOptions
+		want   string
+	}{
+		{"%Y/%m/%d", persian, "۱۴۰۳/۰۷/۰۵"},
+		{"%T %z", persian, "۰۹:۰۷:۰۳ +۰۳۳۰"},
+		{"%d %B %y", persian, "۰۵ مهر ۰۳"},
+		{"%R", arabic, "٠٩:٠٧"},
+		{"%H%M%S", golali.FormatOptions{}, "090703"},
+		{"1%d", persian, "1۰۵"}, // literal text is left alone
+	}
+	for _, tt := range tests {
+		if got := j.FormatWith(tt.layout, tt.opts); got != tt.want {
+			t.Errorf("FormatWith(%q, %+v) = %q, want %q", tt.layout, tt.opts, got, tt.want)
+		}
+	}
+
+	if got := j.StringWith(persian); got != "۱۴۰۳/۰۷/۰۵ ۰۹:۰۷:۰۳" {
+		t.Errorf("StringWith = %q", got)
+	}
+	if got := j.FormatDateTimeWith(persian); got != "۱۴۰۳/۰۷/۰۵ ۰۹:۰۷" {
+		t.Errorf("FormatDateTimeWith = %q", got)
+	}
+}
+
+func TestFormatNames(t *testing.T) {
+	// 1403/07/17 is a Seshanbe (Tuesday).
+	j := golali.Date(1403, golali.Mehr, 17, 10, 0, 0, 0, time.UTC)
+	tests := []struct {
+		layout string
+		want   string
+	}{
+		{"%d %EB %Y, %Ew", "17 Mehr 1403, Seshanbeh"},
+		{"%Eb %d", "Meh 17"},
+		{"%EA %Ea", "Tuesday Tue"},
+		{"%a %w", "س سه‌شنبه"},
+		{"%B %b", "مهر مهر"},
+		{"%E", "%E"},
+		{"%EQ", "%EQ"},
+	}
+	for _, tt := range tests {
+		if got := j.Format(tt.layout); got != tt.want {
+			t.Errorf("Format(%q) = %q, want %q", tt.layout, got, tt.want)
+		}
+	}
+
+	// The transliteration matches EnWeekDays and the Joomeh constant.
+	friday := j.AddDays(3)
+	if got := friday.Format("%Ew"); got != "Joomeh" || got != golali.Joomeh.String() {
+		t.Errorf("Format(%%Ew) for a Joomeh = %q", got)
+	}
+	if got := friday.FormatLocale("%w", golali.EnglishLocale()); got != "Joomeh" {
+		t.Errorf("EnglishLocale %%w for a Joomeh = %q", got)
+	}
+
+	dey := golali.Date(1403, golali.Dey, 1, 0, 0, 0, 0, time.UTC)
+	if got := dey.Format("%b %Eb"); got != "دی Dey" {
+		t.Errorf("Format(%%b %%Eb) for Dey = %q", got)
+	}
+}
+
+func TestCompileLayout(t *testing.T) {
+	j := golali.Date(1403, golali.Mehr, 17, 9, 7, 3, 0, time.FixedZone("", 12600))
+	persian := golali.FormatOptions{Digits: golali.PersianDigits}
+	layouts := []string{
+		"%Y/%m/%d %T",
+		"%w %d %B %Y",
+		"%EA, %Eb %d %z",
+		"plain text",
+		"100%% %Q %E %",
+		"",
+	}
+	for _, layout := range layouts {
+		l := golali.CompileLayout(layout)
+		if got := l.String(); got != layout {
+			t.Errorf("CompileLayout(%q).String() = %q", layout, got)
+		}
+		if got, want := l.Format(j), j.Format(layout); got != want {
+			t.Errorf("CompileLayout(%q).Format = %q, want %q", layout, got, want)
+		}
+		if got, want := string(l.AppendFormatWith([]byte("x"), j, persian)), "x"+j.FormatWith(layout, persian); got != want {
+			t.Errorf("CompileLayout(%q).AppendFormatWith = %q, want %q", layout, got, want)
+		}
+		if got, want := string(j.AppendFormat([]byte("x"), layout)), "x"+j.Format(layout); got != want {
+			t.Errorf("AppendFormat(%q) = %q, want %q", layout, got, want)
+		}
+	}
+}
+
+func TestAppendFormatAllocs(t *testing.T) {
+	j := golali.Date(1403, golali.Mehr, 17, 9, 7, 3, 0, time.UTC)
+	l := golali.CompileLayout("%Y/%m/%d %T")
+	buf := make([]byte, 0, 64)
+	if n := testing.AllocsPerRun(100, func() { buf = j.AppendFormat(buf[:0], "%Y/%m/%d %T") }); n != 0 {
+		t.Errorf("AppendFormat allocates %v times, want 0", n)
+	}
+	if n := testing.AllocsPerRun(100, func() { buf = l.AppendFormat(buf[:0], j) }); n != 0 {
+		t.Errorf("Layout.AppendFormat allocates %v times, want 0", n)
+	}
+}
+
+func BenchmarkFormat(b *testing.B) {
+	j := golali.Date(1403, golali.Mehr, 17, 9, 7, 3, 0, time.UTC)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = j.Format("%Y/%m/%d %T")
+	}
+}
+
+func BenchmarkAppendFormat(b *testing.B) {
+	j := golali.Date(1403, golali.Mehr, 17, 9, 7, 3, 0, time.UTC)
+	buf := make([]byte, 0, 64)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		buf = j.AppendFormat(buf[:0], "%Y/%m/%d %T")
+	}
+}
+
+func BenchmarkLayoutAppendFormat(b *testing.B) {
+	j := golali.Date(1403, golali.Mehr, 17, 9, 7, 3, 0, time.UTC)
+	l := golali.CompileLayout("%Y/%m/%d %T")
+	buf := make([]byte, 0, 64)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		buf = l.AppendFormat(buf[:0], j)
+	}
+}
+
+func TestFormatFlags(t *testing.T) {
+	// 1403/07/05 is a Panjshanbe (Thursday) and the 191st day of the year.
+	j := golali.Date(1403, golali.Mehr, 5, 9, 7, 3, 0, time.UTC)
+	tests := []struct {
+		layout string
+		want   string
+	}{
+		{"%-d/%-m/%Y", "5/7/1403"},
+		{"%_d|%_m", " 5| 7"},
+		{"%e|%0e|%-e", " 5|05|5"},
+		{"%j %-j", "191 191"},
+		{"%u", "6"},
+		{"%5d|%_5d|%-5d", "00005|    5|5"},
+		{"%^EB %^Ea", "MEHR THU"},
+		{"%8EB|%08EB", "    Mehr|0000Mehr"},
+		{"%5B", "  مهر"},
+		{"%-H:%M", "9:07"},
+		{"%10T", "  09:07:03"},
+		{"%s", "1727341623"},
+		{"%-%", "%"},
+		{"%#d %5", "%#d %5"},
+	}
+	for _, tt := range tests {
+		if got := j.Format(tt.layout); got != tt.want {
+			t.Errorf("Format(%q) = %q, want %q", tt.layout, got, tt.want)
+		}
+		if got := golali.CompileLayout(tt.layout).Format(j); got != tt.want {
+			t.Errorf("CompileLayout(%q).Format = %q, want %q", tt.layout, got, tt.want)
+		}
+	}
+
+	persian := golali.FormatOptions{Digits: golali.PersianDigits}
+	if got := j.FormatWith("%-d %B", persian); got != "۵ مهر" {
+		t.Errorf("FormatWith(%%-d %%B) = %q", got)
+	}
+	if got := j.FormatWith("%e|%3m", persian); got != " ۵|۰۰۷" {
+		t.Errorf("FormatWith(%%e|%%3m) = %q", got)
+	}
+
+	shanbe := golali.Date(1403, golali.Farvardin, 4, 0, 0, 0, 0, time.UTC)
+	if got := shanbe.Format("%u %-j %a"); got != "1 4 ش" {
+		t.Errorf("Format(%%u %%-j %%a) for a Shanbe = %q", got)
+	}
+	last := golali.Date(1403, golali.Esfand, 30, 0, 0, 0, 0, time.UTC)
+	if got := last.Format("%u %j %a"); got != "6 366 پ" {
+		t.Errorf("Format(%%u %%j %%a) at year end = %q", got)
+	}
+}
@@ -42,4 +42,27 @@ func TestRoundTripConversion(t *testing.T) {
 		back.Hour() != j.Hour() || back.Minute() != j.Minute() || back.Second() != j.Second() {
 		t.Fatalf("round-trip failed: original %v, back %v", j, back)
 	}
-}
\ No newline at end of file
+}
+
+func TestRoundTripEarlyYears(t *testing.T) {
+	// The conversions count from 979 (1600); walk every day before it.
+	g := golali.Date(1, golali.Farvardin, 1, 0, 0, 0, 0, time.UTC).ToTime()
+	end := golali.Date(980, golali.Farvardin, 1, 0, 0, 0, 0, time.UTC).ToTime()
+	prev := golali.ToJalaliDateTime(g)
+	if prev.Year() != 1 || prev.Month() != golali.Farvardin || prev.Day() != 1 {
+		t.Fatalf("round trip of 0001/01/01 gave %v", prev)
+	}
+	for g = g.AddDate(0, 0, 1); !g.After(end); g = g.AddDate(0, 0, 1) {
+		j := golali.ToJalaliDateTime(g)
+		next := j.Day() == prev.Day()+1 && j.Month() == prev.Month() && j.Year() == prev.Year()
+		first := j.Day() == 1 && (j.Month() == prev.Month()+1 && j.Year() == prev.Year() ||
+			j.Month() == golali.Farvardin && prev.Month() == golali.Esfand && j.Year() == prev.Year()+1)
+		if !next && !first || prev.Day() != prev.DaysInMonth() && first {
+			t.Fatalf("%v follows %v", j, prev)
+		}
+		if back := golali.Date(j.Year(), j.Month(), j.Day(), 0, 0, 0, 0, time.UTC).ToTime(); !back.Equal(g) {
+			t.Fatalf("%v converts back to %v, want %v", j, back, g)
+		}
+		prev = j
+	}
+}
@@ -0,0 +1,310 @@
+package golali
+
+import (
+	_ "embed"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// EnHijriMonthName contains the names of the months in the lunar Hijri
+// calendar in English transliteration.
+var EnHijriMonthName = []string{
+	"",
+	"Muharram", "Safar", "Rabi al-Awwal",
+	"Rabi al-Thani", "Jumada al-Ula", "Jumada al-Thani",
+	"Rajab", "Shaban", "Ramadan",
+	"Shawwal", "Dhu al-Qadah", "Dhu al-Hijjah",
+}
+
+// FaHijriMonthName contains the names of the months in the lunar Hijri
+// calendar as written in Persian.
+var FaHijriMonthName = []string{
+	"",
+	"محرم", "صفر", "ربیع‌الاول",
+	"ربیع‌الثانی", "جمادی‌الاول", "جمادی‌الثانی",
+	"رجب", "شعبان", "رمضان",
+	"شوال", "ذی‌القعده", "ذی‌الحجه",
+}
+
+// ArHijriMonthName contains the names of the months in the lunar Hijri
+// calendar in Arabic.
+var ArHijriMonthName = []string{
+	"",
+	"محرم", "صفر", "ربيع الأول",
+	"ربيع الآخر", "جمادى الأولى", "جمادى الآخرة",
+	"رجب", "شعبان", "رمضان",
+	"شوال", "ذو القعدة", "ذو الحجة",
+}
+
+// HijriMonth represents a month in the lunar Hijri (Qamari) calendar.
+type HijriMonth int
+
+const (
+	Muharram HijriMonth = 1 + iota
+	Safar
+	RabiAlAwwal
+	RabiAlThani
+	JumadaAlUla
+	JumadaAlThani
+	Rajab
+	Shaban
+	Ramadan
+	Shawwal
+	DhuAlQadah
+	DhuAlHijjah
+)
+
+// String returns the English name of the month.
+func (m HijriMonth) String() string {
+	return EnHijriMonthName[m.index()+1]
+}
+
+// FaString returns the Persian name of the month.
+func (m HijriMonth) FaString() string {
+	return FaHijriMonthName[m.index()+1]
+}
+
+// ArString returns the Arabic name of the month.
+func (m HijriMonth) ArString() string {
+	return ArHijriMonthName[m.index()+1]
+}
+
+// index returns the zero-based position of the month.
+func (m HijriMonth) index() int {
+	if m < 1 || m > 12 {
+		panic(fmt.Sprintf("invalid Hijri month value: %v", int(m)))
+	}
+	return int(m) - 1
+}
+
+// hijriMonthName returns the name of m in names, or "" for the zero
+// HijriDate of a day before the epoch.
+func hijriMonthName(names []string, m HijriMonth) string {
+	if m < Muharram || m > DhuAlHijjah {
+		return ""
+	}
+	return names[m]
+}
+
+// HijriConvention selects how lunar Hijri dates are computed.
+type HijriConvention int
+
+const (
+	// HijriTabular is the arithmetical calendar of 30-year cycles with
+	// 11 leap years, counted from the civil epoch of 16 July 622 (Julian).
+	// It can differ from the observed calendar by a day or two.
+	HijriTabular HijriConvention = iota
+
+	// HijriIran starts each month on the official Iranian date where the
+	// table embedded in the package knows it, and on the tabular date
+	// elsewhere. A month between a known and an unknown start may be a
+	// day shorter or longer than usual.
+	HijriIran
+)
+
+// HijriDate is a date in the lunar Hijri calendar.
+type HijriDate struct {
+	Year  int
+	Month HijriMonth
+	Day   int
+}
+
+// String returns the date as "1446/04/01".
+func (h HijriDate) String() string {
+	var buf [16]byte
+	b := appendInt(buf[:0], int64(h.Year), 4, '0', ASCIIDigits)
+	b = append(b, '/')
+	b = appendInt(b, int64(h.Month), 2, '0', ASCIIDigits)
+	b = append(b, '/')
+	return string(appendInt(b, int64(h.Day), 2, '0', ASCIIDigits))
+}
+
+// Hijri returns the lunar Hijri date of the day of j. The day changes at
+// midnight in the location of j, as the civil calendar does, not at sunset.
+// Dates before 1 Muharram 1 (1/04/28 in the Jalali calendar) have no Hijri
+// date and give the zero HijriDate.
+func (j JalaliDateTime) Hijri(conv HijriConvention) HijriDate {
+	n := jalaliDayNumber(j.year, j.month, j.day)
+	if n < hijriEpoch {
+		return HijriDate{}
+	}
+	h := tabularFromDay(n)
+	if conv != HijriIran {
+		return h
+	}
+	// The official month starts differ from the tabular ones by a day or
+	// two, so the day is in the tabular month or one next to it.
+	m := 12*int64(h.Year-1) + int64(h.Month-1)
+	t := iranHijri()
+	if n < t.start(m) {
+		m--
+	} else if n >= t.start(m+1) {
+		m++
+	}
+	return HijriDate{
+		Year:  int(floorDiv(m, 12)) + 1,
+		Month: HijriMonth(m - 12*floorDiv(m, 12) + 1),
+		Day:   int(n-t.start(m)) + 1,
+	}
+}
+
+// FromHijri returns the start of the day with the lunar Hijri date h in loc.
+// It returns an error if h is not a valid date under conv, or falls after
+// the Jalali year 9999.
+func FromHijri(h HijriDate, conv HijriConvention, loc *time.Location) (JalaliDateTime, error) {
+	if h.Year < 1 || h.Year > maxHijriYear || h.Month < Muharram || h.Month > DhuAlHijjah {
+		return JalaliDateTime{}, fmt.Errorf("golali: invalid Hijri date %v", h)
+	}
+	start, length := tabularMonth(h.Year, h.Month)
+	if conv == HijriIran {
+		m := 12*int64(h.Year-1) + int64(h.Month-1)
+		t := iranHijri()
+		start = t.start(m)
+		length = int(t.start(m+1) - start)
+	}
+	if h.Day < 1 || h.Day > length {
+		return JalaliDateTime{}, fmt.Errorf("golali: invalid Hijri date %v: %v has %d days", h, h.Month, length)
+	}
+	year, month, day := jalaliFromDayNumber(start + int64(h.Day) - 1)
+	if year > 9999 {
+		return JalaliDateTime{}, fmt.Errorf("golali: Hijri date %v is after the Jalali year 9999", h)
+	}
+	return newJalali(year, month, day, 0, 0, 0, 0, loc), nil
+}
+
+const (
+	// hijriEpoch is the day number of 1 Muharram 1, the civil epoch of the
+	// tabular calendar.
+	hijriEpoch = -492148
+
+	// maxHijriYear is the Hijri year of 9999/12/29, the last Jalali date.
+	maxHijriYear = 10306
+)
+
+// tabularMonth returns the day number of the first day of the month in the
+// tabular calendar, and the length of the month. Odd months have 30 days
+// and even months 29, except Dhu al-Hijjah in the 11 leap years of each
+// 30-year cycle.
+func tabularMonth(year int, month HijriMonth) (start int64, length int) {
+	start = hijriEpoch + int64(year-1)*354 + floorDiv(3+11*int64(year), 30) +
+		29*int64(month-1) + int64(6*month-1)/11
+	length = 30 - int(month+1)%2
+	if month == DhuAlHijjah && (14+11*year)%30 < 11 {
+		length = 30
+	}
+	return start, length
+}
+
+// tabularFromDay returns the tabular Hijri date of the day number n.
+func tabularFromDay(n int64) HijriDate {
+	year := int(floorDiv(30*(n-hijriEpoch)+10646, 10631))
+	yearStart, _ := tabularMonth(year, Muharram)
+	month := HijriMonth(floorDiv(11*(n-yearStart)+330, 325))
+	start, _ := tabularMonth(year, month)
+	return HijriDate{Year: year, Month: month, Day: int(n-start) + 1}
+}
+
+//go:embed hijri_iran.txt
+var hijriIranData string
+
+// hijriTable maps months, counted as 12*(year-1) + month-1, to the day
+// number of their official first day.
+type hijriTable map[int64]int64
+
+var (
+	iranHijriOnce  sync.Once
+	iranHijriTable hijriTable
+)
+
+// iranHijri returns the parsed table of official Iranian month starts.
+func iranHijri() hijriTable {
+	iranHijriOnce.Do(func() {
+		t, err := parseHijriTable(hijriIranData)
+		if err != nil {
+			panic(err)
+		}
+		iranHijriTable = t
+	})
+	return iranHijriTable
+}
+
+// start returns the day number of the first day of month m: the official
+// date if the table has it, and the tabular date otherwise.
+func (t hijriTable) start(m int64) int64 {
+	if s, ok := t[m]; ok {
+		return s
+	}
+	year := floorDiv(m, 12)
+	s, _ := tabularMonth(int(year)+1, HijriMonth(m-12*year+1))
+	return s
+}
+
+// parseHijriTable reads the format described at the top of hijri_iran.txt.
+func parseHijriTable(s string) (hijriTable, error) {
+	t := hijriTable{}
+	var last hijriMonthStart
+	for n, line := range strings.Split(s, "\n") {
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		fail := func(msg string) (hijriTable, error) {
+			return nil, fmt.Errorf("golali: Hijri table line %d: %s: %q", n+1, msg, line)
+		}
+		hijri, jalali, ok := strings.Cut(line, "\t")
+		h, ok1 := splitDate(hijri, 2)
+		d, ok2 := splitDate(jalali, 3)
+		if !ok || !ok1 || !ok2 || h[1] < 1 || h[1] > 12 || d[1] < 1 || d[1] > 12 ||
+			d[2] < 1 || d[2] > daysInMonth(d[0], Month(d[1])) {
+			return fail("malformed entry")
+		}
+		e := hijriMonthStart{
+			month: 12*int64(h[0]-1) + int64(h[1]-1),
+			start: jalaliDayNumber(d[0], Month(d[1]), d[2]),
+		}
+		if len(t) > 0 && (e.month <= last.month || e.start <= last.start) {
+			return fail("entries out of order")
+		}
+		// Hijri relies on the official starts being close to the tabular ones.
+		if d := e.start - t.start(e.month); d < -2 || d > 2 {
+			return fail("more than two days from the tabular calendar")
+		}
+		if len(t) > 0 && e.month == last.month+1 {
+			if l := e.start - last.start; l < 29 || l > 30 {
+				return fail("previous month has " + strconv.FormatInt(l, 10) + " days")
+			}
+		}
+		t[e.month] = e.start
+		last = e
+	}
+	return t, nil
+}
+
+// hijriMonthStart is an entry of the table being parsed.
+type hijriMonthStart struct {
+	month int64 // 12*(year-1) + month-1
+	start int64 // day number of the first day
+}
+
+// splitDate splits a date of n slash-separated positive numbers.
+func splitDate(s string, n int) ([]int, bool) {
+	parts := strings.Split(s, "/")
+	if len(parts) != n {
+		return nil, false
+	}
+	v := make([]int, n)
+	for i, p := range parts {
+		x, err := strconv.Atoi(p)
+		if err != nil || x < 1 {
+			return nil, false
+		}
+		v[i] = x
+	}
+	return v, true
+}
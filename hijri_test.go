@@ -0,0 +1,146 @@
+package golali_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bijanghanei/golali"
+)
+
+func TestHijri(t *testing.T) {
+	tests := []struct {
+		year  int
+		month golali.Month
+		day   int
+		conv  golali.HijriConvention
+		want  string
+	}{
+		{1404, golali.Azar, 27, golali.HijriTabular, "1447/06/27"},
+		{1404, golali.Azar, 27, golali.HijriIran, "1447/06/27"},
+		{1403, golali.Tir, 17, golali.HijriTabular, "1445/12/30"},
+		{1403, golali.Tir, 17, golali.HijriIran, "1446/01/01"}, // moon sighted a day late
+		{1403, golali.Tir, 26, golali.HijriIran, "1446/01/10"}, // Ashura
+		{1404, golali.Farvardin, 11, golali.HijriIran, "1446/10/01"},
+		{1401, golali.Farvardin, 1, golali.HijriIran, "1443/08/17"},  // before the table
+		{1402, golali.Esfand, 21, golali.HijriIran, "1445/08/30"},    // Sha'ban ends a day later
+		{1402, golali.Esfand, 21, golali.HijriTabular, "1445/09/01"}, // than in the tabular calendar
+	}
+	for _, tt := range tests {
+		j := golali.Date(tt.year, tt.month, tt.day, 23, 59, 0, 0, golali.IRST())
+		if got := j.Hijri(tt.conv).String(); got != tt.want {
+			t.Errorf("%v.Hijri(%v) = %s, want %s", j, tt.conv, got, tt.want)
+		}
+	}
+}
+
+func TestFromHijriRoundTrip(t *testing.T) {
+	start := golali.Date(1400, golali.Farvardin, 1, 0, 0, 0, 0, golali.IRST())
+	for _, conv := range []golali.HijriConvention{golali.HijriTabular, golali.HijriIran} {
+		prev := golali.HijriDate{}
+		for i := 0; i < 3*366; i++ {
+			j := start.AddDays(i)
+			h := j.Hijri(conv)
+			back, err := golali.FromHijri(h, conv, golali.IRST())
+			if err != nil {
+				t.Fatalf("FromHijri(%v, %v): %v", h, conv, err)
+			}
+			if back.Format("%Y/%m/%d") != j.Format("%Y/%m/%d") {
+				t.Fatalf("FromHijri(%v, %v) = %v, want %v", h, conv, back, j)
+			}
+			if i > 0 && h.Day != prev.Day+1 && (h.Day != 1 || prev.Day < 29) {
+				t.Fatalf("%v: Hijri(%v) went from %v to %v", j, conv, prev, h)
+			}
+			prev = h
+		}
+	}
+}
+
+func TestFromHijriInvalid(t *testing.T) {
+	// Dhu al-Hijjah 1445 had 29 days in Iran but 30 in the tabular calendar.
+	h := golali.HijriDate{Year: 1445, Month: golali.DhuAlHijjah, Day: 30}
+	if _, err := golali.FromHijri(h, golali.HijriTabular, golali.IRST()); err != nil {
+		t.Errorf("FromHijri(%v, HijriTabular): %v", h, err)
+	}
+	if _, err := golali.FromHijri(h, golali.HijriIran, golali.IRST()); err == nil {
+		t.Errorf("FromHijri(%v, HijriIran) did not fail", h)
+	}
+	for _, h := range []golali.HijriDate{{1446, 0, 1}, {1446, 13, 1}, {0, golali.Muharram, 1}, {1446, golali.Safar, 30}} {
+		if _, err := golali.FromHijri(h, golali.HijriTabular, golali.IRST()); err == nil {
+			t.Errorf("FromHijri(%+v) did not fail", h)
+		}
+	}
+}
+
+func TestHijriMonthNames(t *testing.T) {
+	m := golali.RabiAlThani
+	if m.String() != "Rabi al-Thani" || m.FaString() != "ربیع‌الثانی" || m.ArString() != "ربيع الآخر" {
+		t.Errorf("names of %d = %q, %q, %q", int(m), m.String(), m.FaString(), m.ArString())
+	}
+	defer func() {
+		if recover() == nil {
+			t.Error("HijriMonth(13).String did not panic")
+		}
+	}()
+	_ = golali.HijriMonth(13).String()
+}
+
+func TestFormatHijri(t *testing.T) {
+	j := golali.Date(1403, golali.Tir, 17, 10, 0, 0, 0, golali.IRST())
+	tests := []struct {
+		layout string
+		opts   golali.FormatOptions
+		want   string
+	}{
+		{"%QY/%Qm/%Qd", golali.FormatOptions{}, "1445/12/30"},
+		{"%QY/%Qm/%Qd", golali.FormatOptions{Hijri: golali.HijriIran}, "1446/01/01"},
+		{"%-d %B %Y / %-Qd %QB %QY", golali.FormatOptions{Digits: golali.PersianDigits, Hijri: golali.HijriIran}, "۱۷ تیر ۱۴۰۳ / ۱ محرم ۱۴۴۶"},
+		{"%Qe %QA", golali.FormatOptions{Hijri: golali.HijriIran}, " 1 محرم"},
+		{"%Qx", golali.FormatOptions{}, "%Qx"},
+	}
+	for _, tt := range tests {
+		if got := j.FormatWith(tt.layout, tt.opts); got != tt.want {
+			t.Errorf("FormatWith(%q) = %q, want %q", tt.layout, got, tt.want)
+		}
+		if got := golali.CompileLayout(tt.layout).AppendFormatWith(nil, j, tt.opts); string(got) != tt.want {
+			t.Errorf("CompileLayout(%q) = %q, want %q", tt.layout, got, tt.want)
+		}
+	}
+}
+
+func TestHijriRange(t *testing.T) {
+	first := golali.HijriDate{Year: 1, Month: golali.Muharram, Day: 1}
+	j, err := golali.FromHijri(first, golali.HijriTabular, time.UTC)
+	if err != nil || j.Format("%Y/%m/%d") != "0001/04/28" {
+		t.Errorf("FromHijri(%v) = %v, %v", first, j, err)
+	}
+	if got := j.Hijri(golali.HijriTabular); got != first {
+		t.Errorf("%v.Hijri = %v", j, got)
+	}
+	before := j.AddDays(-1)
+	if got := before.Hijri(golali.HijriTabular); got != (golali.HijriDate{}) {
+		t.Errorf("%v.Hijri = %v, want the zero HijriDate", before, got)
+	}
+	if got := before.Format("%QY/%Qm/%Qd %QB %QA"); got != "0000/00/00  " {
+		t.Errorf("Format of %v = %q", before, got)
+	}
+
+	h := golali.HijriDate{Year: 1000, Month: golali.Muharram, Day: 1} // 1591-10-19
+	j, err = golali.FromHijri(h, golali.HijriIran, time.UTC)
+	if err != nil || j.Format("%Y/%m/%d") != "0970/07/27" || j.Hijri(golali.HijriIran) != h {
+		t.Errorf("FromHijri(%v) = %v, %v", h, j, err)
+	}
+
+	last := golali.Date(9999, golali.Esfand, 29, 0, 0, 0, 0, time.UTC)
+	h = last.Hijri(golali.HijriTabular)
+	if j, err := golali.FromHijri(h, golali.HijriTabular, time.UTC); err != nil || !j.Equal(last) {
+		t.Errorf("FromHijri(%v) = %v, %v", h, j, err)
+	}
+	h.Day++
+	if _, err := golali.FromHijri(h, golali.HijriTabular, time.UTC); err == nil {
+		t.Errorf("FromHijri(%v) after the Jalali year 9999 did not fail", h)
+	}
+	h = golali.HijriDate{Year: 10400, Month: golali.Muharram, Day: 1}
+	if _, err := golali.FromHijri(h, golali.HijriTabular, time.UTC); err == nil {
+		t.Errorf("FromHijri(%v) did not fail", h)
+	}
+}
@@ -0,0 +1,246 @@
+// Package holidays lists the official public holidays of Iran.
+//
+// Solar holidays, such as Nowruz and 22 Bahman, fall on the same Jalali date
+// every year. Lunar holidays are fixed in the Hijri Qamari calendar and their
+// Jalali dates depend on the sighting of the moon, so they are taken from the
+// official calendar published for each year. Both come from a table embedded
+// in the package; Version identifies it and Covers reports the years it
+// holds lunar holidays for, currently 1403 to 1405. For other years only
+// the solar holidays are known, and On and InYear say so with an error
+// wrapping ErrNotCovered:
+//
+//	list, err := holidays.On(golali.Now())
+//	if err != nil {
+//		// the lunar holidays of this year are unknown
+//	}
+//	if len(list) > 0 {
+//		// closed today
+//	}
+package holidays
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bijanghanei/golali"
+)
+
+//go:embed holidays.txt
+var data string
+
+// Kind tells solar holidays from lunar ones.
+type Kind int
+
+const (
+	// Solar holidays fall on the same Jalali date every year.
+	Solar Kind = 1 + iota
+	// Lunar holidays follow the Hijri Qamari calendar and move every year.
+	Lunar
+)
+
+// String returns the name of the kind.
+func (k Kind) String() string {
+	switch k {
+	case Solar:
+		return "Solar"
+	case Lunar:
+		return "Lunar"
+	default:
+		return "Kind(" + strconv.Itoa(int(k)) + ")"
+	}
+}
+
+// Holiday is an official public holiday on a Jalali date.
+type Holiday struct {
+	Year   int
+	Month  golali.Month
+	Day    int
+	Name   string // Persian name, as in the official calendar
+	EnName string // English name
+	Kind   Kind
+}
+
+// Date returns the start of the holiday in loc.
+func (h Holiday) Date(loc *time.Location) golali.JalaliDateTime {
+	return golali.Date(h.Year, h.Month, h.Day, 0, 0, 0, 0, loc)
+}
+
+// ErrNotCovered is returned by On and InYear for a year whose lunar holidays
+// are not in the embedded table.
+var ErrNotCovered = errors.New("holidays: no lunar holiday data for year")
+
+// Version returns the version of the embedded holiday table.
+func Version() string {
+	return load().version
+}
+
+// Covers reports whether the embedded table holds the lunar holidays of the
+// Jalali year, and so whether InYear and IsHoliday are complete for it.
+func Covers(year int) bool {
+	return load().years[year]
+}
+
+// InYear returns the holidays of the Jalali year in date order. Two holidays
+// that fall on the same date are both listed, the solar one first.
+//
+// For a year the table does not cover, InYear returns the solar holidays
+// alone together with an error wrapping ErrNotCovered.
+func InYear(year int) ([]Holiday, error) {
+	t := load()
+	list := make([]Holiday, 0, len(t.solar)+len(t.lunar[year]))
+	for _, h := range t.solar {
+		h.Year = year
+		list = append(list, h)
+	}
+	list = append(list, t.lunar[year]...)
+	sort.SliceStable(list, func(i, k int) bool {
+		if list[i].Month != list[k].Month {
+			return list[i].Month < list[k].Month
+		}
+		return list[i].Day < list[k].Day
+	})
+	if !t.years[year] {
+		return list, fmt.Errorf("%w %d", ErrNotCovered, year)
+	}
+	return list, nil
+}
+
+// On returns the holidays that fall on the date of j, or nil if it is a
+// working day. Only the date matters; the time and location of j are ignored.
+//
+// For a year the table does not cover, On checks the solar holidays alone
+// and also returns an error wrapping ErrNotCovered.
+func On(j golali.JalaliDateTime) ([]Holiday, error) {
+	t := load()
+	var list []Holiday
+	for _, h := range t.solar {
+		if h.Month == j.Month() && h.Day == j.Day() {
+			h.Year = j.Year()
+			list = append(list, h)
+		}
+	}
+	for _, h := range t.lunar[j.Year()] {
+		if h.Month == j.Month() && h.Day == j.Day() {
+			list = append(list, h)
+		}
+	}
+	if !t.years[j.Year()] {
+		return list, fmt.Errorf("%w %d", ErrNotCovered, j.Year())
+	}
+	return list, nil
+}
+
+// IsHoliday reports whether the date of j is an official holiday, in the
+// form golali.HolidayFunc expects. It has no way to report a year the table
+// does not cover: there it recognises the solar holidays alone, so check
+// Covers or call On when the answer must be complete.
+//
+// Fridays are the weekly day of rest but are not holidays in this sense.
+func IsHoliday(j golali.JalaliDateTime) bool {
+	list, _ := On(j)
+	return len(list) > 0
+}
+
+// table is the parsed form of the embedded data.
+type table struct {
+	version string
+	years   map[int]bool
+	solar   []Holiday // Year is zero
+	lunar   map[int][]Holiday
+}
+
+var (
+	loadOnce sync.Once
+	loaded   *table
+)
+
+func load() *table {
+	loadOnce.Do(func() {
+		t, err := parseTable(data)
+		if err != nil {
+			panic(err)
+		}
+		loaded = t
+	})
+	return loaded
+}
+
+// commonYear is a Jalali year with 29 days in Esfand.
+const commonYear = 1404
+
+// parseTable reads the format described at the top of holidays.txt.
+func parseTable(s string) (*table, error) {
+	t := &table{years: map[int]bool{}, lunar: map[int][]Holiday{}}
+	for n, line := range strings.Split(s, "\n") {
+		if i := strings.Index(line, "#"); i >= 0 {
+			line = line[:i]
+		}
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		fail := func(msg string) (*table, error) {
+			return nil, fmt.Errorf("holidays: line %d: %s: %q", n+1, msg, line)
+		}
+		fields := strings.Split(line, "\t")
+		switch fields[0] {
+		case "version":
+			if len(fields) != 2 {
+				return fail("malformed version")
+			}
+			t.version = fields[1]
+			continue
+		case "years":
+			for _, f := range fields[1:] {
+				y, err := strconv.Atoi(f)
+				if err != nil {
+					return fail("malformed year")
+				}
+				t.years[y] = true
+			}
+			continue
+		}
+
+		if len(fields) != 3 {
+			return fail("want date, Persian name and English name")
+		}
+		date := strings.Split(fields[0], "/")
+		if len(date) != 3 {
+			return fail("malformed date")
+		}
+		month, err1 := strconv.Atoi(date[1])
+		day, err2 := strconv.Atoi(date[2])
+		if err1 != nil || err2 != nil || month < 1 || month > 12 || day < 1 {
+			return fail("malformed date")
+		}
+		h := Holiday{Month: golali.Month(month), Day: day, Name: fields[1], EnName: fields[2]}
+		if date[0] == "*" {
+			// A solar holiday must exist in common years as well as leap ones.
+			if day > golali.Date(commonYear, h.Month, 1, 0, 0, 0, 0, time.UTC).DaysInMonth() {
+				return fail("day out of range for month")
+			}
+			h.Kind = Solar
+			t.solar = append(t.solar, h)
+			continue
+		}
+		year, err := strconv.Atoi(date[0])
+		if err != nil || year < 1 || year > 9999 {
+			return fail("malformed date")
+		}
+		if day > golali.Date(year, h.Month, 1, 0, 0, 0, 0, time.UTC).DaysInMonth() {
+			return fail("day out of range for month")
+		}
+		h.Year, h.Kind = year, Lunar
+		t.lunar[year] = append(t.lunar[year], h)
+	}
+	if t.version == "" {
+		return nil, errors.New("holidays: missing version")
+	}
+	return t, nil
+}
@@ -0,0 +1,166 @@
+package holidays_test
+
+import (
+	"errors"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/bijanghanei/golali"
+	"github.com/bijanghanei/golali/holidays"
+)
+
+func TestVersion(t *testing.T) {
+	if holidays.Version() == "" {
+		t.Error("Version is empty")
+	}
+	for _, year := range []int{1403, 1404, 1405} {
+		if !holidays.Covers(year) {
+			t.Errorf("Covers(%d) = false", year)
+		}
+	}
+	if holidays.Covers(1500) {
+		t.Error("Covers(1500) = true")
+	}
+}
+
+func TestInYear(t *testing.T) {
+	for _, year := range []int{1403, 1404, 1405} {
+		list, err := holidays.InYear(year)
+		if err != nil {
+			t.Fatalf("InYear(%d): %v", year, err)
+		}
+		lunar := 0
+		for i, h := range list {
+			if h.Year != year {
+				t.Errorf("InYear(%d)[%d].Year = %d", year, i, h.Year)
+			}
+			if h.Name == "" || h.EnName == "" {
+				t.Errorf("InYear(%d)[%d] has no name: %+v", year, i, h)
+			}
+			if h.Kind == holidays.Lunar {
+				lunar++
+			}
+			if i > 0 && h.Date(golali.IRST()).Before(list[i-1].Date(golali.IRST())) {
+				t.Errorf("InYear(%d) is not in date order at %d", year, i)
+			}
+		}
+		if len(list)-lunar != 10 || lunar < 16 {
+			t.Errorf("InYear(%d) has %d solar and %d lunar holidays", year, len(list)-lunar, lunar)
+		}
+	}
+}
+
+func TestInYearNotCovered(t *testing.T) {
+	list, err := holidays.InYear(1500)
+	if !errors.Is(err, holidays.ErrNotCovered) {
+		t.Errorf("InYear(1500) error = %v, want ErrNotCovered", err)
+	}
+	if len(list) != 10 {
+		t.Errorf("InYear(1500) returned %d holidays, want the 10 solar ones", len(list))
+	}
+	for _, h := range list {
+		if h.Kind != holidays.Solar || h.Year != 1500 {
+			t.Errorf("InYear(1500) returned %+v", h)
+		}
+	}
+}
+
+func TestIsHoliday(t *testing.T) {
+	tests := []struct {
+		year  int
+		month golali.Month
+		day   int
+		want  bool
+	}{
+		{1404, golali.Farvardin, 1, true},
+		{1404, golali.Farvardin, 5, false},
+		{1404, golali.Farvardin, 13, true},
+		{1404, golali.Khordad, 14, true},
+		{1404, golali.Bahman, 22, true},
+		{1404, golali.Bahman, 23, false},
+		{1404, golali.Esfand, 29, true},
+		{1404, golali.Tir, 15, true},  // Ashura
+		{1403, golali.Tir, 15, false}, // Ashura fell on 26 Tir in 1403
+		{1403, golali.Tir, 26, true},
+		{1405, golali.Farvardin, 2, true}, // Nowruz and Eid al-Fitr
+		{1405, golali.Tir, 5, true},       // Ashura
+		{1350, golali.Farvardin, 2, true}, // solar holidays apply to any year
+	}
+	for _, tt := range tests {
+		j := golali.Date(tt.year, tt.month, tt.day, 15, 0, 0, 0, golali.IRST())
+		if got := holidays.IsHoliday(j); got != tt.want {
+			t.Errorf("IsHoliday(%v) = %v, want %v", j, got, tt.want)
+		}
+	}
+}
+
+func TestOn(t *testing.T) {
+	// 12 Farvardin 1404 was both Islamic Republic Day and the second day of
+	// Eid al-Fitr.
+	list, err := holidays.On(golali.Date(1404, golali.Farvardin, 12, 0, 0, 0, 0, golali.IRST()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 2 || list[0].Kind != holidays.Solar || list[1].Kind != holidays.Lunar {
+		t.Fatalf("On(1404/01/12) = %+v", list)
+	}
+	if list[0].EnName != "Islamic Republic Day" || list[0].Year != 1404 {
+		t.Errorf("On(1404/01/12)[0] = %+v", list[0])
+	}
+	if got, err := holidays.On(golali.Date(1404, golali.Mehr, 1, 0, 0, 0, 0, golali.IRST())); got != nil || err != nil {
+		t.Errorf("On(1404/07/01) = %+v, %v, want nil", got, err)
+	}
+}
+
+func TestOnNotCovered(t *testing.T) {
+	// The solar holidays are still found, but the missing lunar ones are
+	// reported.
+	list, err := holidays.On(golali.Date(1500, golali.Farvardin, 1, 0, 0, 0, 0, golali.IRST()))
+	if !errors.Is(err, holidays.ErrNotCovered) || len(list) != 1 || list[0].Kind != holidays.Solar {
+		t.Errorf("On(1500/01/01) = %+v, %v", list, err)
+	}
+	list, err = holidays.On(golali.Date(1500, golali.Mehr, 1, 0, 0, 0, 0, golali.IRST()))
+	if !errors.Is(err, holidays.ErrNotCovered) || list != nil {
+		t.Errorf("On(1500/07/01) = %+v, %v", list, err)
+	}
+}
+
+func TestLunarHijriDates(t *testing.T) {
+	// Each lunar row notes its Hijri date, which must match the Iranian
+	// month starts of golali.HijriIran.
+	data, err := os.ReadFile("holidays.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	months := map[string]golali.HijriMonth{}
+	for m := golali.Muharram; m <= golali.DhuAlHijjah; m++ {
+		months[m.String()] = m
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		row, note, ok := strings.Cut(line, "# ")
+		if !ok || strings.HasPrefix(row, "*") || row == "" {
+			continue
+		}
+		date := strings.Split(strings.Fields(row)[0], "/")
+		hf := strings.Fields(note)
+		var v [5]int
+		var errs [5]error
+		v[0], errs[0] = strconv.Atoi(date[0])
+		v[1], errs[1] = strconv.Atoi(date[1])
+		v[2], errs[2] = strconv.Atoi(date[2])
+		v[3], errs[3] = strconv.Atoi(hf[0])
+		v[4], errs[4] = strconv.Atoi(hf[len(hf)-1])
+		month, known := months[strings.ReplaceAll(strings.Join(hf[1:len(hf)-1], " "), "'", "")]
+		if errors.Join(errs[:]...) != nil || !known {
+			t.Errorf("malformed lunar row %q", line)
+			continue
+		}
+		want := golali.HijriDate{Year: v[4], Month: month, Day: v[3]}
+		j := golali.Date(v[0], golali.Month(v[1]), v[2], 0, 0, 0, 0, golali.IRST())
+		if got := j.Hijri(golali.HijriIran); got != want {
+			t.Errorf("%v.Hijri(HijriIran) = %v, want %v from %q", j, got, want, note)
+		}
+	}
+}
@@ -0,0 +1,287 @@
+package golali
+
+import "time"
+
+// HumanizeOptions controls the output of HumanizeWith.
+// The zero value writes Persian with ASCII digits, one unit of precision
+// and the default thresholds.
+type HumanizeOptions struct {
+	// English writes English phrases, such as "5 minutes ago", instead of
+	// Persian ones.
+	English bool
+
+	// Digits is the digit system used for counts.
+	Digits DigitSystem
+
+	// Precision is the number of units written, largest first:
+	// 2 gives "۲ ساعت و ۵ دقیقه پیش". Values below 1 mean 1.
+	Precision int
+
+	// Compact writes abbreviated units without a direction, such as
+	// "۵ د" or "5m", for narrow layouts.
+	Compact bool
+
+	// Thresholds decides when a larger unit is used.
+	Thresholds HumanizeThresholds
+}
+
+// HumanizeThresholds decides which unit describes a difference. Each count
+// field is the number of that unit from which the next larger unit is used;
+// for example Minutes: 45 writes 44 minutes as minutes and 45 minutes as
+// "1 hour". A zero field keeps its default.
+type HumanizeThresholds struct {
+	JustNow time.Duration // differences below this read "just now"; default 10s
+	Seconds int           // default 60
+	Minutes int           // default 60
+	Hours   int           // default 24
+	Days    int           // default 7
+	Weeks   int           // default 5
+	Months  int           // default 12
+}
+
+// DefaultHumanizeThresholds are the thresholds used for zero fields.
+var DefaultHumanizeThresholds = HumanizeThresholds{
+	JustNow: 10 * time.Second,
+	Seconds: 60,
+	Minutes: 60,
+	Hours:   24,
+	Days:    7,
+	Weeks:   5,
+	Months:  12,
+}
+
+// Humanize describes t relative to ref in Persian with Persian digits, such
+// as "۵ دقیقه پیش", "دیروز", "۲ هفته دیگر" or "پارسال". Counts are rounded
+// from the elapsed time, while the named words such as "دیروز" and
+// "پارسال" are used only for the previous or next calendar day, week
+// (from Shanbe), month or year in the location of ref.
+func Humanize(t, ref JalaliDateTime) string {
+	return HumanizeWith(t, ref, HumanizeOptions{Digits: PersianDigits})
+}
+
+// HumanizeWith is like Humanize but applies the given options.
+func HumanizeWith(t, ref JalaliDateTime, opts HumanizeOptions) string {
+	words := &faHumanWords
+	if opts.English {
+		words = &enHumanWords
+	}
+
+	d := t.Sub(ref)
+	past := d < 0
+	if past {
+		d = -d
+	}
+	th := opts.Thresholds.withDefaults()
+	if d < th.JustNow {
+		if opts.Compact {
+			return words.compactNow
+		}
+		return words.now
+	}
+
+	secs := int64(d / time.Second)
+	limits := [...]int{th.Seconds, th.Minutes, th.Hours, th.Days, th.Weeks, th.Months}
+	u := unitSecond
+	for u < unitYear && roundDiv(secs, humanUnitSeconds[u]) >= int64(limits[u]) {
+		u++
+	}
+
+	precision := opts.Precision
+	if precision < 1 {
+		precision = 1
+	}
+	var parts [unitYear + 1]int64
+	first, last := u, u
+	rem := secs
+	for unit := u; unit >= unitSecond && first-unit < humanUnit(precision); unit-- {
+		parts[unit] = rem / humanUnitSeconds[unit]
+		rem -= parts[unit] * humanUnitSeconds[unit]
+		last = unit
+	}
+	// Round the smallest unit written and carry into the larger ones.
+	if 2*rem >= humanUnitSeconds[last] {
+		parts[last]++
+	}
+	for unit := last; unit < first; unit++ {
+		if parts[unit]*humanUnitSeconds[unit] >= humanUnitSeconds[unit+1] {
+			parts[unit+1]++
+			parts[unit] = 0
+		}
+	}
+	if parts[first] == 0 {
+		parts[first] = 1
+	}
+
+	// The named words go by the calendar: "yesterday" is the previous date,
+	// whatever the number of hours.
+	if !opts.Compact && u >= unitDay && onlyUnit(parts[:], u) {
+		switch calendarDistance(t, ref, u) {
+		case -1:
+			return words.lastOne[u]
+		case 1:
+			return words.nextOne[u]
+		}
+	}
+
+	affix := words.future
+	if past {
+		affix = words.past
+	}
+	var b []byte
+	if !opts.Compact {
+		b = append(b, affix[0]...)
+	}
+	written := false
+	for unit := first; unit >= last; unit-- {
+		n := parts[unit]
+		if n == 0 && (written || unit != last) {
+			continue
+		}
+		if written {
+			b = append(b, words.sep(opts.Compact)...)
+		}
+		written = true
+		b = appendInt(b, n, 0, '0', opts.Digits)
+		switch {
+		case opts.Compact:
+			b = append(b, words.compactGap...)
+			b = append(b, words.compactUnits[unit]...)
+		case n != 1:
+			b = append(b, ' ')
+			b = append(b, words.pluralUnits[unit]...)
+		default:
+			b = append(b, ' ')
+			b = append(b, words.units[unit]...)
+		}
+	}
+	if !opts.Compact {
+		b = append(b, affix[1]...)
+	}
+	return string(b)
+}
+
+// calendarDistance returns how many calendar days, weeks, months or years
+// the date of t is after that of ref, both read in the location of ref.
+// Weeks start on Shanbe.
+func calendarDistance(t, ref JalaliDateTime, u humanUnit) int64 {
+	t = ToJalaliDateTime(t.t.In(ref.t.Location()))
+	day, refDay := jalaliDayNumber(t.year, t.month, t.day), jalaliDayNumber(ref.year, ref.month, ref.day)
+	switch u {
+	case unitDay:
+		return day - refDay
+	case unitWeek:
+		// Day zero, 1970-01-01, is a Panjshanbe.
+		return floorDiv(day+5, 7) - floorDiv(refDay+5, 7)
+	case unitMonth:
+		return int64(12*(t.year-ref.year) + int(t.month-ref.month))
+	default:
+		return int64(t.year - ref.year)
+	}
+}
+
+// roundDiv returns a/b rounded to the nearest integer.
+func roundDiv(a, b int64) int64 {
+	return (a + b/2) / b
+}
+
+// onlyUnit reports whether u is the only non-zero entry of parts.
+func onlyUnit(parts []int64, u humanUnit) bool {
+	for unit, n := range parts {
+		if n != 0 && humanUnit(unit) != u {
+			return false
+		}
+	}
+	return true
+}
+
+// withDefaults replaces the zero fields of th with their defaults.
+func (th HumanizeThresholds) withDefaults() HumanizeThresholds {
+	def := DefaultHumanizeThresholds
+	if th.JustNow <= 0 {
+		th.JustNow = def.JustNow
+	}
+	if th.Seconds <= 0 {
+		th.Seconds = def.Seconds
+	}
+	if th.Minutes <= 0 {
+		th.Minutes = def.Minutes
+	}
+	if th.Hours <= 0 {
+		th.Hours = def.Hours
+	}
+	if th.Days <= 0 {
+		th.Days = def.Days
+	}
+	if th.Weeks <= 0 {
+		th.Weeks = def.Weeks
+	}
+	if th.Months <= 0 {
+		th.Months = def.Months
+	}
+	return th
+}
+
+// humanUnit is a unit used by HumanizeWith.
+type humanUnit int
+
+const (
+	unitSecond humanUnit = iota
+	unitMinute
+	unitHour
+	unitDay
+	unitWeek
+	unitMonth
+	unitYear
+)
+
+// humanUnitSeconds holds the length of each unit. Months and years are
+// approximated by 30 and 365 days.
+var humanUnitSeconds = [...]int64{1, 60, 3600, 86400, 7 * 86400, 30 * 86400, 365 * 86400}
+
+// humanWords holds the phrases of one language.
+type humanWords struct {
+	now, compactNow    string
+	past, future       [2]string // written before and after the counts
+	and                string    // joins the units of a multi-unit result
+	compactGap         string    // separates a count from a compact unit
+	units, pluralUnits [unitYear + 1]string
+	compactUnits       [unitYear + 1]string
+	lastOne, nextOne   [unitYear + 1]string // single day, week, month or year
+}
+
+func (w *humanWords) sep(compact bool) string {
+	if compact {
+		return " "
+	}
+	return w.and
+}
+
+// Persian nouns stay singular after a number, so units and pluralUnits are
+// the same.
+var faHumanWords = humanWords{
+	now:          "همین الان",
+	compactNow:   "الان",
+	past:         [2]string{"", " پیش"},
+	future:       [2]string{"", " دیگر"},
+	and:          " و ",
+	compactGap:   " ",
+	units:        [...]string{"ثانیه", "دقیقه", "ساعت", "روز", "هفته", "ماه", "سال"},
+	pluralUnits:  [...]string{"ثانیه", "دقیقه", "ساعت", "روز", "هفته", "ماه", "سال"},
+	compactUnits: [...]string{"ث", "د", "س", "ر", "ه", "م", "سال"},
+	lastOne:      [...]string{unitDay: "دیروز", unitWeek: "هفته پیش", unitMonth: "ماه پیش", unitYear: "پارسال"},
+	nextOne:      [...]string{unitDay: "فردا", unitWeek: "هفته آینده", unitMonth: "ماه آینده", unitYear: "سال آینده"},
+}
+
+var enHumanWords = humanWords{
+	now:          "just now",
+	compactNow:   "now",
+	past:         [2]string{"", " ago"},
+	future:       [2]string{"in ", ""},
+	and:          ", ",
+	compactGap:   "",
+	units:        [...]string{"second", "minute", "hour", "day", "week", "month", "year"},
+	pluralUnits:  [...]string{"seconds", "minutes", "hours", "days", "weeks", "months", "years"},
+	compactUnits: [...]string{"s", "m", "h", "d", "w", "mo", "y"},
+	lastOne:      [...]string{unitDay: "yesterday", unitWeek: "last week", unitMonth: "last month", unitYear: "last year"},
+	nextOne:      [...]string{unitDay: "tomorrow", unitWeek: "next week", unitMonth: "next month", unitYear: "next year"},
+}
@@ -0,0 +1,99 @@
+package golali_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bijanghanei/golali"
+)
+
+func TestHumanize(t *testing.T) {
+	ref := golali.Date(1403, golali.Mehr, 15, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		d    time.Duration
+		want string
+	}{
+		{0, "همین الان"},
+		{-5 * time.Second, "همین الان"},
+		{-30 * time.Second, "۳۰ ثانیه پیش"},
+		{-5 * time.Minute, "۵ دقیقه پیش"},
+		{3 * time.Hour, "۳ ساعت دیگر"},
+		{-24 * time.Hour, "دیروز"},
+		{30 * time.Hour, "فردا"},
+		{36 * time.Hour, "۲ روز دیگر"},
+		{-3 * 24 * time.Hour, "۳ روز پیش"},
+		{14 * 24 * time.Hour, "۲ هفته دیگر"},
+		{-7 * 24 * time.Hour, "هفته پیش"},
+		{-60 * 24 * time.Hour, "۲ ماه پیش"},
+		{-400 * 24 * time.Hour, "پارسال"},
+		{3 * 365 * 24 * time.Hour, "۳ سال دیگر"},
+	}
+	for _, tt := range tests {
+		if got := golali.Humanize(ref.Add(tt.d), ref); got != tt.want {
+			t.Errorf("Humanize(%v) = %q, want %q", tt.d, got, tt.want)
+		}
+	}
+}
+
+func TestHumanizeCalendarWords(t *testing.T) {
+	at := func(y int, m golali.Month, d, h, min int) golali.JalaliDateTime {
+		return golali.Date(y, m, d, h, min, 0, 0, golali.IRST())
+	}
+	tests := []struct {
+		ref  golali.JalaliDateTime
+		d    time.Duration
+		want string
+	}{
+		// Around midnight the named days follow the date, not the hours.
+		{at(1403, golali.Mehr, 15, 23, 0), 25 * time.Hour, "۱ روز دیگر"},
+		{at(1403, golali.Mehr, 15, 12, 0), -36 * time.Hour, "دیروز"},
+		{at(1403, golali.Mehr, 15, 0, 30), -(23*time.Hour + 45*time.Minute), "دیروز"},
+		{at(1403, golali.Mehr, 15, 23, 45), -(23*time.Hour + 30*time.Minute), "۱ روز پیش"},
+		// 1403/07/14 is a Shanbe, the first day of its week.
+		{at(1403, golali.Mehr, 14, 10, 0), -8 * 24 * time.Hour, "۱ هفته پیش"},
+		{at(1403, golali.Mehr, 19, 10, 0), -7 * 24 * time.Hour, "هفته پیش"},
+		// Around Nowruz the named months and years follow the calendar.
+		{at(1403, golali.Farvardin, 5, 12, 0), -370 * 24 * time.Hour, "۱ سال پیش"},
+		{at(1403, golali.Farvardin, 2, 12, 0), -360 * 24 * time.Hour, "پارسال"},
+		{at(1402, golali.Esfand, 29, 12, 0), 2 * 24 * time.Hour, "۲ روز دیگر"},
+		{at(1402, golali.Esfand, 20, 12, 0), 40 * 24 * time.Hour, "ماه آینده"},
+		{at(1402, golali.Bahman, 25, 12, 0), 40 * 24 * time.Hour, "۱ ماه دیگر"},
+	}
+	for _, tt := range tests {
+		if got := golali.Humanize(tt.ref.Add(tt.d), tt.ref); got != tt.want {
+			t.Errorf("Humanize(%v, %v) = %q, want %q", tt.ref.Add(tt.d), tt.ref, got, tt.want)
+		}
+	}
+}
+
+func TestHumanizeWith(t *testing.T) {
+	ref := golali.Date(1403, golali.Mehr, 15, 12, 0, 0, 0, time.UTC)
+	en := golali.HumanizeOptions{English: true}
+	tests := []struct {
+		d    time.Duration
+		opts golali.HumanizeOptions
+		want string
+	}{
+		{-time.Minute, en, "1 minute ago"},
+		{-5 * time.Minute, en, "5 minutes ago"},
+		{2 * time.Hour, en, "in 2 hours"},
+		{-24 * time.Hour, en, "yesterday"},
+		{time.Second, en, "just now"},
+		{-5 * time.Minute, golali.HumanizeOptions{}, "5 دقیقه پیش"},
+		{-5 * time.Minute, golali.HumanizeOptions{Digits: golali.PersianDigits, Compact: true}, "۵ د"},
+		{-5 * time.Minute, golali.HumanizeOptions{English: true, Compact: true}, "5m"},
+		{-26 * time.Hour, golali.HumanizeOptions{English: true, Compact: true}, "1d"},
+		{time.Second, golali.HumanizeOptions{Compact: true}, "الان"},
+		{-(2*time.Hour + 5*time.Minute), golali.HumanizeOptions{Precision: 2}, "2 ساعت و 5 دقیقه پیش"},
+		{-(2*time.Hour + 5*time.Minute), golali.HumanizeOptions{English: true, Precision: 2}, "2 hours, 5 minutes ago"},
+		{-(2 * time.Hour), golali.HumanizeOptions{English: true, Precision: 2}, "2 hours ago"},
+		{-(26 * time.Hour), golali.HumanizeOptions{English: true, Precision: 2}, "1 day, 2 hours ago"},
+		{-50 * time.Minute, golali.HumanizeOptions{English: true, Thresholds: golali.HumanizeThresholds{Minutes: 45}}, "1 hour ago"},
+		{-30 * time.Second, golali.HumanizeOptions{English: true, Thresholds: golali.HumanizeThresholds{JustNow: time.Minute}}, "just now"},
+	}
+	for _, tt := range tests {
+		if got := golali.HumanizeWith(ref.Add(tt.d), ref, tt.opts); got != tt.want {
+			t.Errorf("HumanizeWith(%v, %+v) = %q, want %q", tt.d, tt.opts, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,193 @@
+package golali
+
+import "time"
+
+// isoLayout describes the interchange format in ParseError values.
+const isoLayout = "YYYY-MM-DDTHH:MM:SS[.fffffffff]±hh:mm"
+
+// FormatISO returns j in the Jalali interchange format, modelled on RFC 3339:
+//
+//	1403-07-15T10:20:30.5+03:30
+//
+// The fraction of a second is written only when it is not zero, without
+// trailing zeros, and a zero offset is written as "Z". ParseISO reads the
+// result back to the same instant and nanosecond.
+func (j JalaliDateTime) FormatISO() string {
+	var buf [40]byte
+	return string(j.AppendISO(buf[:0]))
+}
+
+// AppendISO is like FormatISO but appends the result to b and returns the
+// extended buffer.
+func (j JalaliDateTime) AppendISO(b []byte) []byte {
+	b = appendInt(b, int64(j.year), 4, '0', ASCIIDigits)
+	b = append(b, '-')
+	b = appendInt(b, int64(j.month), 2, '0', ASCIIDigits)
+	b = append(b, '-')
+	b = appendInt(b, int64(j.day), 2, '0', ASCIIDigits)
+	b = append(b, 'T')
+	b = appendInt(b, int64(j.hour), 2, '0', ASCIIDigits)
+	b = append(b, ':')
+	b = appendInt(b, int64(j.min), 2, '0', ASCIIDigits)
+	b = append(b, ':')
+	b = appendInt(b, int64(j.sec), 2, '0', ASCIIDigits)
+	if ns := j.nanosec; ns != 0 {
+		digits := 9
+		for ns%10 == 0 {
+			ns /= 10
+			digits--
+		}
+		b = append(b, '.')
+		b = appendInt(b, int64(ns), digits, '0', ASCIIDigits)
+	}
+
+	_, offset := j.Zone()
+	if offset == 0 {
+		return append(b, 'Z')
+	}
+	if offset < 0 {
+		b = append(b, '-')
+		offset = -offset
+	} else {
+		b = append(b, '+')
+	}
+	b = appendInt(b, int64(offset/3600), 2, '0', ASCIIDigits)
+	b = append(b, ':')
+	return appendInt(b, int64(offset%3600/60), 2, '0', ASCIIDigits)
+}
+
+// isoFields lists the fixed-width fields of the interchange format and the
+// separator in front of each.
+var isoFields = [...]struct {
+	elem  string
+	sep   byte
+	width int
+}{
+	{"YYYY", 0, 4},
+	{"MM", '-', 2},
+	{"DD", '-', 2},
+	{"HH", 'T', 2},
+	{"MM", ':', 2},
+	{"SS", ':', 2},
+}
+
+// ParseISO parses a value written by FormatISO. Unlike Parse it is strict:
+// every field must have its full width in ASCII digits, and the offset is
+// required. The result is in time.UTC for a zero offset and in a fixed zone
+// otherwise.
+//
+// Any error returned is a *ParseError describing the offending element.
+func ParseISO(value string) (JalaliDateTime, error) {
+	fail := func(elem string, pos, end int, kind ParseErrorKind, msg string) (JalaliDateTime, error) {
+		if end > len(value) {
+			end = len(value)
+		}
+		return JalaliDateTime{}, &ParseError{
+			Layout:     isoLayout,
+			Value:      value,
+			LayoutElem: elem,
+			ValueElem:  value[pos:end],
+			Offset:     pos,
+			Kind:       kind,
+			Message:    msg,
+		}
+	}
+
+	var f [len(isoFields)]int
+	pos := 0
+	for i, e := range isoFields {
+		if e.sep != 0 {
+			if pos >= len(value) || value[pos] != e.sep {
+				return fail(string(e.sep), pos, pos+1, Mismatch, "")
+			}
+			pos++
+		}
+		n, ok := asciiNumber(value[pos:], e.width)
+		if !ok {
+			return fail(e.elem, pos, pos+e.width, Mismatch, "")
+		}
+		f[i] = n
+		pos += e.width
+	}
+	year, month, day, hour, min, sec := f[0], f[1], f[2], f[3], f[4], f[5]
+	switch {
+	case year < 1:
+		return fail("YYYY", 0, 4, OutOfRange, "year out of range (1-9999)")
+	case month < 1 || month > 12:
+		return fail("MM", 5, 7, OutOfRange, "month out of range (1-12)")
+	case day < 1 || day > daysInMonth(year, Month(month)):
+		return fail("DD", 8, 10, OutOfRange, "day out of range for month")
+	case hour > 23:
+		return fail("HH", 11, 13, OutOfRange, "hour out of range (0-23)")
+	case min > 59:
+		return fail("MM", 14, 16, OutOfRange, "minute out of range (0-59)")
+	case sec > 59:
+		return fail("SS", 17, 19, OutOfRange, "seconds out of range (0-59)")
+	}
+
+	nsec := 0
+	if pos < len(value) && value[pos] == '.' {
+		pos++
+		start := pos
+		for pos < len(value) && value[pos] >= '0' && value[pos] <= '9' {
+			pos++
+		}
+		if pos == start || pos-start > 9 {
+			return fail(".fffffffff", start-1, pos, Mismatch, "fraction must have 1 to 9 digits")
+		}
+		nsec, _ = asciiNumber(value[start:pos], pos-start)
+		for i := pos - start; i < 9; i++ {
+			nsec *= 10
+		}
+	}
+
+	if pos >= len(value) {
+		return fail("±hh:mm", pos, pos, Mismatch, "missing time zone offset")
+	}
+	loc := time.UTC
+	switch value[pos] {
+	case 'Z':
+		pos++
+	case '+', '-':
+		hh, ok1 := asciiNumber(value[pos+1:], 2)
+		colon := pos+3 < len(value) && value[pos+3] == ':'
+		mm, ok2 := 0, false
+		if colon {
+			mm, ok2 = asciiNumber(value[pos+4:], 2)
+		}
+		if !ok1 || !ok2 || hh > 23 || mm > 59 {
+			return fail("±hh:mm", pos, pos+6, Mismatch, "")
+		}
+		offset := hh*3600 + mm*60
+		if value[pos] == '-' {
+			offset = -offset
+		}
+		if offset != 0 {
+			loc = time.FixedZone("", offset)
+		}
+		pos += 6
+	default:
+		return fail("±hh:mm", pos, pos+1, Mismatch, "")
+	}
+	if pos < len(value) {
+		return fail("", pos, len(value), Mismatch, "extra text: "+value[pos:])
+	}
+
+	return Date(year, Month(month), day, hour, min, sec, nsec, loc), nil
+}
+
+// asciiNumber reads exactly width ASCII digits from the start of s.
+func asciiNumber(s string, width int) (int, bool) {
+	if len(s) < width {
+		return 0, false
+	}
+	n := 0
+	for i := 0; i < width; i++ {
+		c := s[i]
+		if c < '0' || c > '9' {
+			return 0, false
+		}
+		n = n*10 + int(c-'0')
+	}
+	return n, true
+}
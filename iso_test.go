@@ -0,0 +1,76 @@
+package golali_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/bijanghanei/golali"
+)
+
+func TestFormatISO(t *testing.T) {
+	tests := []struct {
+		j    golali.JalaliDateTime
+		want string
+	}{
+		{golali.Date(1403, golali.Mehr, 15, 10, 20, 30, 500000000, golali.IRST()), "1403-07-15T10:20:30.5+03:30"},
+		{golali.Date(1403, golali.Mehr, 15, 10, 20, 30, 0, time.UTC), "1403-07-15T10:20:30Z"},
+		{golali.Date(1403, golali.Mehr, 15, 10, 20, 30, 123456789, time.FixedZone("", -5*3600)), "1403-07-15T10:20:30.123456789-05:00"},
+		{golali.Date(99, golali.Farvardin, 1, 0, 0, 0, 1000, time.UTC), "0099-01-01T00:00:00.000001Z"},
+	}
+	for _, tt := range tests {
+		got := tt.j.FormatISO()
+		if got != tt.want {
+			t.Errorf("FormatISO() = %q, want %q", got, tt.want)
+		}
+		back, err := golali.ParseISO(got)
+		if err != nil {
+			t.Errorf("ParseISO(%q): %v", got, err)
+			continue
+		}
+		if !back.ToTime().Equal(tt.j.ToTime()) || back.Nanosecond() != tt.j.Nanosecond() {
+			t.Errorf("ParseISO(%q) = %v, want %v", got, back, tt.j)
+		}
+		if _, off := back.Zone(); off != offsetOf(tt.j) {
+			t.Errorf("ParseISO(%q) has offset %d", got, off)
+		}
+	}
+}
+
+func offsetOf(j golali.JalaliDateTime) int {
+	_, off := j.Zone()
+	return off
+}
+
+func TestParseISOErrors(t *testing.T) {
+	tests := []struct {
+		value string
+		kind  golali.ParseErrorKind
+		elem  string
+	}{
+		{"1403-7-15T10:20:30Z", golali.Mismatch, "MM"},
+		{"1403/07/15T10:20:30Z", golali.Mismatch, "-"},
+		{"1403-07-15 10:20:30Z", golali.Mismatch, "T"},
+		{"۱۴۰۳-07-15T10:20:30Z", golali.Mismatch, "YYYY"},
+		{"1403-07-15T10:20:30", golali.Mismatch, "±hh:mm"},
+		{"1403-07-15T10:20:30+0330", golali.Mismatch, "±hh:mm"},
+		{"1403-07-15T10:20:30.Z", golali.Mismatch, ".fffffffff"},
+		{"1403-07-15T10:20:30.1234567891Z", golali.Mismatch, ".fffffffff"},
+		{"1403-07-15T10:20:30Zx", golali.Mismatch, ""},
+		{"1403-13-15T10:20:30Z", golali.OutOfRange, "MM"},
+		{"1404-12-30T10:20:30Z", golali.OutOfRange, "DD"},
+		{"1403-07-15T24:20:30Z", golali.OutOfRange, "HH"},
+		{"0000-07-15T10:20:30Z", golali.OutOfRange, "YYYY"},
+	}
+	for _, tt := range tests {
+		_, err := golali.ParseISO(tt.value)
+		var pe *golali.ParseError
+		if !errors.As(err, &pe) {
+			t.Errorf("ParseISO(%q) error = %v, want *ParseError", tt.value, err)
+			continue
+		}
+		if pe.Kind != tt.kind || pe.LayoutElem != tt.elem {
+			t.Errorf("ParseISO(%q) = %v %q, want %v %q", tt.value, pe.Kind, pe.LayoutElem, tt.kind, tt.elem)
+		}
+	}
+}
@@ -0,0 +1,15 @@
+package golali
+
+// Standard layouts for Format and Parse, in the spirit of time.DateOnly and
+// time.RFC3339. Every layout round-trips: parsing the output of Format with
+// the same layout gives back the formatted fields.
+const (
+	DateOnly    = "%Y/%m/%d"            // 1403/07/15
+	TimeOnly    = "%H:%M:%S"            // 10:20:30
+	DateTime    = "%Y/%m/%d %H:%M:%S"   // 1403/07/15 10:20:30
+	ISODate     = "%Y-%m-%d"            // 1403-07-15
+	ISODateTime = "%Y-%m-%dT%H:%M:%S%z" // 1403-07-15T10:20:30+0330
+	LongDate    = "%d %B %Y"            // 15 مهر 1403
+	WeekdayDate = "%w، %d %B %Y"        // یکشنبه، 15 مهر 1403
+	FileName    = "%Y-%m-%d_%H-%M-%S"   // 1403-07-15_10-20-30, safe in file names
+)
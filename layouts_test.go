@@ -0,0 +1,91 @@
+package golali_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/bijanghanei/golali"
+)
+
+func TestLayoutsRoundTrip(t *testing.T) {
+	layouts := []string{
+		golali.DateOnly,
+		golali.TimeOnly,
+		golali.DateTime,
+		golali.ISODate,
+		golali.ISODateTime,
+		golali.LongDate,
+		golali.WeekdayDate,
+		golali.FileName,
+	}
+	dates := []golali.JalaliDateTime{
+		golali.Date(1403, golali.Mehr, 15, 10, 20, 30, 0, golali.IRST()),
+		golali.Date(1403, golali.Esfand, 30, 23, 59, 59, 0, time.UTC),
+		golali.Date(1399, golali.Shahrivar, 3, 0, 0, 0, 0, golali.IRST()),
+		golali.Date(1402, golali.Dey, 1, 7, 5, 9, 0, time.FixedZone("", -4*3600-1800)),
+	}
+	for _, layout := range layouts {
+		for _, j := range dates {
+			s := j.Format(layout)
+			p := golali.Parser{Location: j.Location()}
+			got, err := p.Parse(layout, s)
+			if err != nil {
+				t.Errorf("Parse(%q, %q): %v", layout, s, err)
+				continue
+			}
+			if back := got.Format(layout); back != s {
+				t.Errorf("layout %q: formatted %q, parsed back as %q", layout, s, back)
+			}
+		}
+	}
+
+	// A full date-time layout gives back the same instant.
+	for _, j := range dates {
+		got, err := golali.Parser{}.Parse(golali.ISODateTime, j.Format(golali.ISODateTime))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !got.ToTime().Equal(j.ToTime()) {
+			t.Errorf("ISODateTime round trip of %v gave %v", j, got)
+		}
+	}
+}
+
+func TestParseLayoutConstants(t *testing.T) {
+	irst := golali.IRST()
+	j, err := golali.ParseInLocation(golali.WeekdayDate, "سه‌شنبه، ۱۷ مهر ۱۴۰۳", irst)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if j.Year() != 1403 || j.Month() != golali.Mehr || j.Day() != 17 {
+		t.Errorf("WeekdayDate parsed as %v", j)
+	}
+
+	j, err = golali.ParseInLocation(golali.ISODateTime, "1403-07-15T10:20:30+03:30", irst)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if j.Location() != irst {
+		t.Errorf("matching offset replaced the location with %v", j.Location())
+	}
+	j, err = golali.ParseInLocation(golali.ISODateTime, "1403-07-15T10:20:30Z", irst)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, off := j.Zone(); off != 0 {
+		t.Errorf("Z parsed with offset %d", off)
+	}
+	if _, err := golali.ParseInLocation(golali.ISODateTime, "1403-07-15T10:20:30+3", irst); err == nil {
+		t.Error("short offset was accepted")
+	}
+	// A rejected offset is reported as whole characters.
+	_, err = golali.ParseInLocation(golali.ISODateTime, "1403-07-15T10:20:30۰۳۳۰", irst)
+	var pe *golali.ParseError
+	if !errors.As(err, &pe) || pe.ValueElem != "۰" {
+		t.Errorf("Persian-digit offset error = %#v", err)
+	}
+	if _, err := golali.ParseInLocation(golali.WeekdayDate, "فردا، ۱۷ مهر ۱۴۰۳", irst); err == nil {
+		t.Error("unknown weekday was accepted")
+	}
+}
@@ -0,0 +1,232 @@
+package golali
+
+import (
+	"time"
+)
+
+// Locale bundles the names and conventions used to format and parse dates
+// for a language or region. Built-in locales are returned by PersianLocale,
+// DariLocale, PashtoLocale, KurdishLocale and EnglishLocale; each call
+// returns a fresh copy that may be modified without affecting other users.
+//
+// A nil *Locale stands for the package defaults: the Persian names in
+// FaJalaliMonthName and FaWeekDays, "صبح"/"عصر" and ASCII digits.
+type Locale struct {
+	// Name identifies the locale, such as "fa-IR".
+	Name string
+
+	// Months and ShortMonths hold the month names, Farvardin first.
+	Months      [12]string
+	ShortMonths [12]string
+
+	// Weekdays and ShortWeekdays hold the weekday names indexed by Weekday,
+	// Yekshanbe first.
+	Weekdays      [7]string
+	ShortWeekdays [7]string
+
+	// AM and PM are written by %p before and after noon.
+	AM, PM string
+
+	// Digits is the digit system used by FormatLocale.
+	Digits DigitSystem
+
+	// Default layouts for the locale, in Format syntax.
+	DateLayout     string
+	TimeLayout     string
+	DateTimeLayout string
+	LongDateLayout string
+}
+
+// MonthName returns the name of m in the locale, or "" if m is not a valid
+// month, such as the month of the zero JalaliDateTime.
+func (l *Locale) MonthName(m Month) string {
+	if m < Farvardin || m > Esfand {
+		return ""
+	}
+	if l == nil {
+		return FaJalaliMonthName[m]
+	}
+	return l.Months[m.index()]
+}
+
+// ShortMonthName returns the abbreviated name of m in the locale, or "" if m
+// is not a valid month.
+func (l *Locale) ShortMonthName(m Month) string {
+	if m < Farvardin || m > Esfand {
+		return ""
+	}
+	if l == nil {
+		return abbreviate(FaJalaliMonthName[m])
+	}
+	return l.ShortMonths[m.index()]
+}
+
+// WeekdayName returns the name of w in the locale.
+func (l *Locale) WeekdayName(w Weekday) string {
+	if l == nil {
+		return w.FaString()
+	}
+	return l.Weekdays[w.index()]
+}
+
+// ShortWeekdayName returns the abbreviated name of w in the locale.
+func (l *Locale) ShortWeekdayName(w Weekday) string {
+	if l == nil {
+		return FaShortWeekDays[w.index()]
+	}
+	return l.ShortWeekdays[w.index()]
+}
+
+// dayPeriod returns the AM or PM marker for hour.
+func (l *Locale) dayPeriod(hour int) string {
+	am, pm := "صبح", "عصر"
+	if l != nil {
+		am, pm = l.AM, l.PM
+	}
+	if hour < 12 {
+		return am
+	}
+	return pm
+}
+
+// FormatLocale formats j according to layout using the names and digit
+// system of the locale.
+func (j JalaliDateTime) FormatLocale(layout string, l *Locale) string {
+	opts := FormatOptions{Locale: l}
+	if l != nil {
+		opts.Digits = l.Digits
+	}
+	return j.FormatWith(layout, opts)
+}
+
+// StringLocale formats j with the DateTimeLayout of the locale.
+func (j JalaliDateTime) StringLocale(l *Locale) string {
+	if l == nil {
+		return j.String()
+	}
+	return j.FormatLocale(l.DateTimeLayout, l)
+}
+
+// ParseLocale parses value according to layout, reading month names from the
+// locale, and returns the result in the given location.
+func ParseLocale(layout, value string, l *Locale, loc *time.Location) (JalaliDateTime, error) {
+	return Parser{Location: loc, Locale: l}.Parse(layout, value)
+}
+
+// PersianLocale returns the locale for Persian as written in Iran.
+func PersianLocale() *Locale {
+	l := &Locale{
+		Name:           "fa-IR",
+		Months:         [12]string{"فروردین", "اردیبهشت", "خرداد", "تیر", "مرداد", "شهریور", "مهر", "آبان", "آذر", "دی", "بهمن", "اسفند"},
+		Weekdays:       [7]string{"یکشنبه", "دوشنبه", "سه‌شنبه", "چهارشنبه", "پنج‌شنبه", "جمعه", "شنبه"},
+		ShortWeekdays:  [7]string{"ی", "د", "س", "چ", "پ", "ج", "ش"},
+		AM:             "صبح",
+		PM:             "عصر",
+		Digits:         PersianDigits,
+		DateLayout:     "%Y/%m/%d",
+		TimeLayout:     "%H:%M:%S",
+		DateTimeLayout: "%Y/%m/%d %H:%M:%S",
+		LongDateLayout: "%w، %d %B %Y",
+	}
+	l.ShortMonths = abbreviateMonths(l.Months)
+	return l
+}
+
+// dariMonths and pashtoMonths are the Afghan month names, which follow the
+// signs of the zodiac.
+var (
+	dariMonths   = [12]string{"حمل", "ثور", "جوزا", "سرطان", "اسد", "سنبله", "میزان", "عقرب", "قوس", "جدی", "دلو", "حوت"}
+	pashtoMonths = [12]string{"وری", "غویی", "غبرګولی", "چنګاښ", "زمری", "وږی", "تله", "لړم", "لیندۍ", "مرغومی", "سلواغه", "کب"}
+)
+
+// dariWeekdays are the Dari weekday names, Yekshanbe first. They are written
+// like the Persian ones.
+var dariWeekdays = [7]string{"یکشنبه", "دوشنبه", "سه‌شنبه", "چهارشنبه", "پنجشنبه", "جمعه", "شنبه"}
+
+// pashtoWeekdays are the Pashto weekday names, Yekshanbe first.
+var pashtoWeekdays = [7]string{"اتوار", "ګل", "نهه", "شورو", "زیارت", "جمعه", "اونۍ"}
+
+// DariLocale returns the locale for Dari as written in Afghanistan, which
+// names the months after the signs of the zodiac.
+func DariLocale() *Locale {
+	return &Locale{
+		Name:           "fa-AF",
+		Months:         dariMonths,
+		ShortMonths:    dariMonths,
+		Weekdays:       dariWeekdays,
+		ShortWeekdays:  [7]string{"ی", "د", "س", "چ", "پ", "ج", "ش"},
+		AM:             "ق.ظ",
+		PM:             "ب.ظ",
+		Digits:         PersianDigits,
+		DateLayout:     "%Y/%m/%d",
+		TimeLayout:     "%H:%M:%S",
+		DateTimeLayout: "%Y/%m/%d %H:%M:%S",
+		LongDateLayout: "%w %d %B %Y",
+	}
+}
+
+// PashtoLocale returns the locale for Pashto as written in Afghanistan.
+func PashtoLocale() *Locale {
+	return &Locale{
+		Name:           "ps-AF",
+		Months:         pashtoMonths,
+		ShortMonths:    pashtoMonths,
+		Weekdays:       pashtoWeekdays,
+		ShortWeekdays:  pashtoWeekdays,
+		AM:             "غ.م",
+		PM:             "غ.و",
+		Digits:         PersianDigits,
+		DateLayout:     "%Y/%m/%d",
+		TimeLayout:     "%H:%M:%S",
+		DateTimeLayout: "%Y/%m/%d %H:%M:%S",
+		LongDateLayout: "%w %d %B %Y",
+	}
+}
+
+// KurdishLocale returns the locale for Central Kurdish (Sorani).
+func KurdishLocale() *Locale {
+	l := &Locale{
+		Name:           "ckb-IR",
+		Months:         [12]string{"خاکەلێوە", "گوڵان", "جۆزەردان", "پووشپەڕ", "گەلاوێژ", "خەرمانان", "ڕەزبەر", "گەڵاڕێزان", "سەرماوەز", "بەفرانبار", "ڕێبەندان", "ڕەشەمە"},
+		Weekdays:       [7]string{"یەکشەممە", "دووشەممە", "سێشەممە", "چوارشەممە", "پێنجشەممە", "ھەینی", "شەممە"},
+		ShortWeekdays:  [7]string{"ی", "د", "س", "چ", "پ", "ھ", "ش"},
+		AM:             "پ.ن",
+		PM:             "د.ن",
+		Digits:         ArabicDigits,
+		DateLayout:     "%Y/%m/%d",
+		TimeLayout:     "%H:%M:%S",
+		DateTimeLayout: "%Y/%m/%d %H:%M:%S",
+		LongDateLayout: "%w، %d %B %Y",
+	}
+	l.ShortMonths = abbreviateMonths(l.Months)
+	return l
+}
+
+// EnglishLocale returns a locale that writes the Persian month and weekday
+// names in Latin letters, for English-language text.
+func EnglishLocale() *Locale {
+	l := &Locale{
+		Name:           "en-IR",
+		Months:         [12]string{"Farvardin", "Ordibehesht", "Khordad", "Tir", "Mordad", "Shahrivar", "Mehr", "Aban", "Azar", "Dey", "Bahman", "Esfand"},
+		Weekdays:       [7]string{"Yekshanbeh", "Doshanbeh", "Seshanbeh", "Chaharshanbeh", "Panjshanbeh", "Joomeh", "Shanbeh"},
+		AM:             "AM",
+		PM:             "PM",
+		Digits:         ASCIIDigits,
+		DateLayout:     "%Y/%m/%d",
+		TimeLayout:     "%H:%M:%S",
+		DateTimeLayout: "%Y/%m/%d %H:%M:%S",
+		LongDateLayout: "%w, %d %B %Y",
+	}
+	l.ShortMonths = abbreviateMonths(l.Months)
+	for i, name := range l.Weekdays {
+		l.ShortWeekdays[i] = abbreviate(name)
+	}
+	return l
+}
+
+func abbreviateMonths(months [12]string) (short [12]string) {
+	for i, name := range months {
+		short[i] = abbreviate(name)
+	}
+	return short
+}
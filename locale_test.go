@@ -0,0 +1,119 @@
+package golali_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bijanghanei/golali"
+)
+
+func TestFormatLocale(t *testing.T) {
+	// 1403/07/17 is a Seshanbe (Tuesday).
+	j := golali.Date(1403, golali.Mehr, 17, 15, 4, 5, 0, time.UTC)
+	tests := []struct {
+		locale *golali.Locale
+		layout string
+		want   string
+	}{
+		{golali.PersianLocale(), "%d %B %Y %p", "۱۷ مهر ۱۴۰۳ عصر"},
+		{golali.DariLocale(), "%d %B %Y %p", "۱۷ میزان ۱۴۰۳ ب.ظ"},
+		{golali.KurdishLocale(), "%d %B %Y", "١٧ ڕەزبەر ١٤٠٣"},
+		{golali.EnglishLocale(), "%a %d %b %Y %p", "Ses 17 Meh 1403 PM"},
+		{golali.EnglishLocale(), golali.EnglishLocale().LongDateLayout, "Seshanbeh, 17 Mehr 1403"},
+		{nil, "%d %B %Y %p", "17 مهر 1403 عصر"},
+	}
+	for _, tt := range tests {
+		if got := j.FormatLocale(tt.layout, tt.locale); got != tt.want {
+			t.Errorf("FormatLocale(%q, %v) = %q, want %q", tt.layout, tt.locale, got, tt.want)
+		}
+	}
+
+	if got := j.StringLocale(golali.PersianLocale()); got != "۱۴۰۳/۰۷/۱۷ ۱۵:۰۴:۰۵" {
+		t.Errorf("StringLocale = %q", got)
+	}
+
+	// FormatWith takes names from the locale but digits from the options.
+	opts := golali.FormatOptions{Locale: golali.DariLocale()}
+	if got := j.FormatWith("%d %B", opts); got != "17 میزان" {
+		t.Errorf("FormatWith with Dari locale = %q", got)
+	}
+}
+
+func TestLocaleIsolation(t *testing.T) {
+	l := golali.PersianLocale()
+	l.Months[6] = "changed"
+	if got := golali.PersianLocale().MonthName(golali.Mehr); got != "مهر" {
+		t.Errorf("modifying a locale leaked into later copies: %q", got)
+	}
+}
+
+func TestFormatZeroValueMonth(t *testing.T) {
+	var zero golali.JalaliDateTime
+	if got := zero.Format("[%B|%b|%EB]"); got != "[||]" {
+		t.Errorf("zero value %%B|%%b|%%EB = %q, want empty names", got)
+	}
+	if got := zero.FormatLocale("[%B|%b]", golali.DariLocale()); got != "[|]" {
+		t.Errorf("zero value with DariLocale = %q, want empty names", got)
+	}
+	var nilLocale *golali.Locale
+	if got := nilLocale.MonthName(0) + nilLocale.ShortMonthName(13); got != "" {
+		t.Errorf("names of invalid months = %q, want empty", got)
+	}
+}
+
+func TestParseLocale(t *testing.T) {
+	j, err := golali.ParseLocale("%d %B %Y", "۱۷ میزان ۱۴۰۳", golali.DariLocale(), time.UTC)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if j.Year() != 1403 || j.Month() != golali.Mehr || j.Day() != 17 {
+		t.Errorf("ParseLocale = %v, want 1403/07/17", j)
+	}
+
+	if _, err := golali.ParseLocale("%d %B %Y", "17 مهر 1403", golali.DariLocale(), time.UTC); err == nil {
+		t.Errorf("ParseLocale accepted an Iranian month name with the Dari locale")
+	}
+
+	p := golali.Parser{Locale: golali.KurdishLocale()}
+	if j, err := p.Parse("%d %b %Y", "١ خاک ١٤٠٣"); err != nil || j.Month() != golali.Farvardin {
+		t.Errorf("Kurdish abbreviated month = %v, %v", j, err)
+	}
+}
+
+func TestAfghanNames(t *testing.T) {
+	if got := golali.Mizan.DariString(); got != "میزان" {
+		t.Errorf("Mehr.DariString() = %q", got)
+	}
+	if got := golali.Hamal.PashtoString(); got != "وری" {
+		t.Errorf("Farvardin.PashtoString() = %q", got)
+	}
+	if got := golali.Joomeh.PashtoString(); got != "جمعه" {
+		t.Errorf("Joomeh.PashtoString() = %q", got)
+	}
+	if got := golali.Panjshanbe.DariString(); got != "پنجشنبه" {
+		t.Errorf("Panjshanbe.DariString() = %q", got)
+	}
+	if got := golali.Jawza.PashtoString(); got != "غبرګولی" {
+		t.Errorf("Khordad.PashtoString() = %q", got)
+	}
+
+	// 1403/01/01 (Nowruz) was a Chaharshanbe, 2024-03-20 in Kabul.
+	j := golali.Date(1403, golali.Hamal, 1, 9, 0, 0, 0, golali.AFT())
+	if got := j.FormatLocale("%w %d %B %Y", golali.PashtoLocale()); got != "شورو ۰۱ وری ۱۴۰۳" {
+		t.Errorf("Pashto format = %q", got)
+	}
+	if got := j.FormatLocale("%d %B %Y", golali.DariLocale()); got != "۰۱ حمل ۱۴۰۳" {
+		t.Errorf("Dari format = %q", got)
+	}
+	if _, offset := j.Zone(); offset != 4*3600+1800 {
+		t.Errorf("Kabul offset = %d, want +04:30", offset)
+	}
+
+	parsed, err := golali.ParseLocale("%d %B %Y", "۱۵ لړم ۱۴۰۳", golali.PashtoLocale(), golali.AFT())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if parsed.Month() != golali.Aqrab || parsed.Day() != 15 {
+		t.Errorf("Pashto parse = %v, want 1403/08/15", parsed)
+	}
+}
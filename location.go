@@ -1,10 +1,66 @@
 package golali
 
-import "time"
+import (
+	"sync"
+	"time"
+)
 
-// IRST returns the Asia/Tehran location.
+// zoneCache loads a location once and keeps it for later calls.
+type zoneCache struct {
+	once sync.Once
+	loc  *time.Location
+	err  error
+}
+
+var (
+	tehranZone zoneCache
+	kabulZone  zoneCache
+)
+
+func (c *zoneCache) load(name string) (*time.Location, error) {
+	c.once.Do(func() {
+		c.loc, c.err = loadZone(name)
+	})
+	return c.loc, c.err
+}
+
+// loadZone reads the named zone from the embedded data, or from the system
+// database when the package is built without it.
+func loadZone(name string) (*time.Location, error) {
+	if data, ok := embeddedZone(name); ok {
+		return time.LoadLocationFromTZData(name, data)
+	}
+	return time.LoadLocation(name)
+}
+
+// LoadIRST returns the Asia/Tehran location, including Iran's historical
+// daylight saving rules. The location is loaded once and shared.
+func LoadIRST() (*time.Location, error) {
+	return tehranZone.load("Asia/Tehran")
+}
+
+// IRST returns the Asia/Tehran location. It panics if the location cannot
+// be loaded, which only happens when the package is built with the
+// golali_notzdata tag on a host without a time zone database; use LoadIRST
+// to handle that case.
 func IRST() *time.Location {
-	loc, err := time.LoadLocation("Asia/Tehran")
+	loc, err := LoadIRST()
+	if err != nil {
+		panic(err)
+	}
+	return loc
+}
+
+// LoadAFT returns the Asia/Kabul location, for Afghanistan. The location is
+// loaded once and shared.
+func LoadAFT() (*time.Location, error) {
+	return kabulZone.load("Asia/Kabul")
+}
+
+// AFT returns the Asia/Kabul location. Like IRST it panics if the location
+// cannot be loaded; use LoadAFT to handle that case.
+func AFT() *time.Location {
+	loc, err := LoadAFT()
 	if err != nil {
 		panic(err)
 	}
@@ -13,29 +69,28 @@ func IRST() *time.Location {
 
 // UTC returns the JalaliDateTime in UTC.
 func (j JalaliDateTime) UTC() JalaliDateTime {
-	return ToJalaliDateTime(j.ToTime().UTC())
+	return ToJalaliDateTime(j.t.UTC())
 }
 
-// Local returns the JalaliDateTime in local time zone.
+// Local returns the JalaliDateTime in the local time zone. Like In, it
+// keeps the instant and changes the wall clock.
 func (j JalaliDateTime) Local() JalaliDateTime {
-	return JalaliDateTime{
-		year:     j.year,
-		month:    j.month,
-		day:      j.day,
-		hour:     j.hour,
-		min:      j.min,
-		sec:      j.sec,
-		nanosec:  j.nanosec,
-		location: time.Local,
-	}
+	return j.In(time.Local)
+}
+
+// WithLocation returns j with the same wall clock in loc, which denotes a
+// different instant unless the two zones share an offset. Use In to keep
+// the instant instead.
+func (j JalaliDateTime) WithLocation(loc *time.Location) JalaliDateTime {
+	return newJalali(j.year, j.month, j.day, j.hour, j.min, j.sec, j.nanosec, loc)
 }
 
 // In returns the JalaliDateTime in the specified time zone.
 func (j JalaliDateTime) In(loc *time.Location) JalaliDateTime {
-	return ToJalaliDateTime(j.ToTime().In(loc))
+	return ToJalaliDateTime(j.t.In(loc))
 }
 
 // Zone returns the time zone name and offset.
 func (j JalaliDateTime) Zone() (string, int) {
-	return j.ToTime().Zone()
+	return j.t.Zone()
 }
\ No newline at end of file
@@ -0,0 +1,97 @@
+package golali_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bijanghanei/golali"
+)
+
+func TestLoadZones(t *testing.T) {
+	irst, err := golali.LoadIRST()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if irst.String() != "Asia/Tehran" {
+		t.Errorf("LoadIRST name = %q", irst.String())
+	}
+	if golali.IRST() != irst {
+		t.Error("IRST does not return the cached location")
+	}
+	aft, err := golali.LoadAFT()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if aft.String() != "Asia/Kabul" || golali.AFT() != aft {
+		t.Errorf("LoadAFT = %v", aft)
+	}
+}
+
+func TestTehranHistoricalOffsets(t *testing.T) {
+	tests := []struct {
+		year   int
+		month  golali.Month
+		day    int
+		offset int
+	}{
+		{1390, golali.Tir, 1, 16200},       // summer 2011, daylight saving
+		{1390, golali.Dey, 1, 12600},       // winter 2011
+		{1385, golali.Tir, 1, 12600},       // 2006, daylight saving suspended
+		{1400, golali.Tir, 1, 16200},       // 2021, the last summer of daylight saving
+		{1402, golali.Tir, 1, 12600},       // 2023, abolished
+		{1357, golali.Farvardin, 1, 14400}, // 1978, when Iran briefly used +04:00
+	}
+	for _, tt := range tests {
+		j := golali.Date(tt.year, tt.month, tt.day, 12, 0, 0, 0, golali.IRST())
+		if _, off := j.Zone(); off != tt.offset {
+			t.Errorf("%v: offset %d, want %d", j, off, tt.offset)
+		}
+	}
+	j := golali.Date(1403, golali.Tir, 1, 12, 0, 0, 0, golali.AFT())
+	if _, off := j.Zone(); off != 16200 {
+		t.Errorf("Kabul offset %d, want 16200", off)
+	}
+}
+
+func TestLocalConvertsInstant(t *testing.T) {
+	saved := time.Local
+	t.Cleanup(func() { time.Local = saved })
+	time.Local = time.FixedZone("UTC-5", -5*3600)
+
+	j := golali.Date(1403, golali.Mehr, 15, 10, 20, 30, 0, golali.IRST())
+	local := j.Local()
+	if !local.ToTime().Equal(j.ToTime()) {
+		t.Errorf("Local changed the instant: %v -> %v", j, local)
+	}
+	if local.Location() != time.Local || local.String() != "1403/07/15 01:50:30" {
+		t.Errorf("Local() = %v in %v", local, local.Location())
+	}
+	if got := j.In(time.Local); got != local {
+		t.Errorf("Local() = %v, In(time.Local) = %v", local, got)
+	}
+	if got := j.UTC().String(); got != "1403/07/15 06:50:30" {
+		t.Errorf("UTC() = %v", got)
+	}
+}
+
+func TestWithLocationKeepsWallClock(t *testing.T) {
+	irst := golali.IRST()
+	j := golali.Date(1403, golali.Mehr, 15, 10, 20, 30, 0, time.UTC)
+	moved := j.WithLocation(irst)
+	if moved.String() != j.String() || moved.Location() != irst {
+		t.Errorf("WithLocation = %v in %v", moved, moved.Location())
+	}
+	if d := j.Sub(moved); d != 3*time.Hour+30*time.Minute {
+		t.Errorf("WithLocation moved the instant by %v, want 3h30m", d)
+	}
+
+	// Across a daylight saving period the shift follows the zone's offset.
+	summer := golali.Date(1390, golali.Tir, 1, 12, 0, 0, 0, time.UTC)
+	if d := summer.Sub(summer.WithLocation(irst)); d != 4*time.Hour+30*time.Minute {
+		t.Errorf("summer WithLocation shift = %v, want 4h30m", d)
+	}
+	back := summer.In(irst).In(time.UTC)
+	if !back.ToTime().Equal(summer.ToTime()) || back.String() != summer.String() {
+		t.Errorf("In round trip gave %v", back)
+	}
+}
@@ -0,0 +1,71 @@
+package golali
+
+import (
+	"errors"
+	"strconv"
+)
+
+// IsZero reports whether j is the zero JalaliDateTime, which holds no date.
+func (j JalaliDateTime) IsZero() bool {
+	return j == JalaliDateTime{}
+}
+
+// validForISO reports an error if j cannot be written in a form ParseISO
+// accepts, such as the zero value.
+func (j JalaliDateTime) validForISO(method string) error {
+	if j.year < 1 || j.year > 9999 {
+		return errors.New("golali: JalaliDateTime." + method + ": year outside of range [1,9999]")
+	}
+	if j.month < Farvardin || j.month > Esfand || j.day < 1 || j.day > daysInMonth(j.year, j.month) {
+		return errors.New("golali: JalaliDateTime." + method + ": invalid date")
+	}
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler using FormatISO.
+func (j JalaliDateTime) MarshalText() ([]byte, error) {
+	if err := j.validForISO("MarshalText"); err != nil {
+		return nil, err
+	}
+	return j.AppendISO(make([]byte, 0, 40)), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseISO.
+func (j *JalaliDateTime) UnmarshalText(data []byte) error {
+	v, err := ParseISO(string(data))
+	if err != nil {
+		return err
+	}
+	*j = v
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler. The date is a string in the
+// FormatISO form.
+func (j JalaliDateTime) MarshalJSON() ([]byte, error) {
+	if err := j.validForISO("MarshalJSON"); err != nil {
+		return nil, err
+	}
+	b := make([]byte, 0, 42)
+	b = append(b, '"')
+	b = j.AppendISO(b)
+	return append(b, '"'), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The date must be a string in
+// the FormatISO form; like time.Time, a JSON null leaves j unchanged.
+func (j *JalaliDateTime) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	s, err := strconv.Unquote(string(data))
+	if err != nil || len(data) == 0 || data[0] != '"' {
+		return errors.New("golali: JalaliDateTime.UnmarshalJSON: input is not a JSON string")
+	}
+	v, err := ParseISO(s)
+	if err != nil {
+		return err
+	}
+	*j = v
+	return nil
+}
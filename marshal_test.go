@@ -0,0 +1,75 @@
+package golali_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/bijanghanei/golali"
+)
+
+func TestMarshalJSON(t *testing.T) {
+	type event struct {
+		At golali.JalaliDateTime `json:"at"`
+	}
+	j := golali.Date(1403, golali.Mehr, 15, 10, 20, 30, 500000000, time.FixedZone("", 12600))
+	data, err := json.Marshal(event{At: j})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"at":"1403-07-15T10:20:30.5+03:30"}`; string(data) != want {
+		t.Errorf("json.Marshal = %s, want %s", data, want)
+	}
+
+	var e event
+	if err := json.Unmarshal(data, &e); err != nil {
+		t.Fatal(err)
+	}
+	if e.At.FormatISO() != j.FormatISO() {
+		t.Errorf("json round trip gave %v, want %v", e.At, j)
+	}
+
+	e = event{At: j}
+	if err := json.Unmarshal([]byte(`{"at":null}`), &e); err != nil || e.At != j {
+		t.Errorf("null changed the value to %v (err %v)", e.At, err)
+	}
+	for _, bad := range []string{`{"at":14030715}`, `{"at":"1403/07/15"}`} {
+		if err := json.Unmarshal([]byte(bad), &e); err == nil {
+			t.Errorf("json.Unmarshal(%s) succeeded", bad)
+		}
+	}
+
+	if _, err := json.Marshal(event{}); err == nil {
+		t.Error("marshaling the zero value succeeded")
+	}
+	if !(golali.JalaliDateTime{}).IsZero() || j.IsZero() {
+		t.Error("IsZero is wrong")
+	}
+}
+
+func TestMarshalText(t *testing.T) {
+	j := golali.Date(1403, golali.Mehr, 15, 10, 20, 30, 0, time.UTC)
+	text, err := j.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(text) != "1403-07-15T10:20:30Z" {
+		t.Errorf("MarshalText = %s", text)
+	}
+	var back golali.JalaliDateTime
+	if err := back.UnmarshalText(text); err != nil {
+		t.Fatal(err)
+	}
+	if back.FormatISO() != j.FormatISO() || back.Location() != time.UTC {
+		t.Errorf("UnmarshalText gave %v in %v", back, back.Location())
+	}
+
+	// JalaliDateTime works as a JSON map key through the text marshaler.
+	data, err := json.Marshal(map[golali.JalaliDateTime]int{j: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `{"1403-07-15T10:20:30Z":1}` {
+		t.Errorf("map key = %s", data)
+	}
+}
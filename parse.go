@@ -2,10 +2,9 @@ package golali
 
 import (
 	"errors"
-	"fmt"
-	"strconv"
 	"strings"
 	"time"
+	"unicode/utf8"
 )
 
 // Parse parses the value according to layout in local time.
@@ -14,105 +13,582 @@ func Parse(layout, value string) (JalaliDateTime, error) {
 }
 
 // ParseInLocation parses the value according to layout in the given location.
+//
+// Any error returned is a *ParseError describing the offending element.
 func ParseInLocation(layout, value string, location *time.Location) (JalaliDateTime, error) {
-	if len(layout) != len(value) {
-		return JalaliDateTime{}, errors.New("value does not match the layout")
+	return Parser{Location: location}.Parse(layout, value)
+}
+
+// Parser parses Jalali dates with configurable behaviour.
+// The zero value parses strictly in a nil (local) location.
+type Parser struct {
+	// Location is the time zone assigned to parsed values.
+	Location *time.Location
+
+	// Lenient makes the parser carry a day past the end of its month
+	// into the following months, so "1404/12/30" becomes 1405/01/01,
+	// instead of returning an OutOfRange error.
+	Lenient bool
+
+	// Locale supplies the month names read by %B and %b. A nil Locale
+	// accepts the Persian and transliterated names of the package tables.
+	Locale *Locale
+
+	// Pivot expands the two-digit years read by YY and %y.
+	// A nil Pivot behaves like SlidingPivotFrom(Clock, 20).
+	Pivot YearPivot
+
+	// Clock supplies the current year to the default Pivot. A nil Clock
+	// means SystemClock.
+	Clock Clock
+
+	// WallTime resolves a value that falls in a daylight saving gap or
+	// overlap of Location. With ResolveReject such values fail with an
+	// OutOfRange error. An offset read by %z always picks the instant
+	// it names.
+	WallTime WallTimePolicy
+}
+
+// YearPivot expands a two-digit year (0-99) into a full Jalali year.
+type YearPivot func(yy int) int
+
+// FixedPivot returns a YearPivot that maps two-digit years below cutoff to
+// the 1400s and the others to the 1300s. FixedPivot(50) turns 00-49 into
+// 1400-1449 and 50-99 into 1350-1399.
+func FixedPivot(cutoff int) YearPivot {
+	return func(yy int) int {
+		if yy < cutoff {
+			return 1400 + yy
+		}
+		return 1300 + yy
 	}
+}
 
-	layoutParts, layoutSeps, err := tokenize(layout)
-	if err != nil {
-		return JalaliDateTime{}, fmt.Errorf("invalid layout: %v", err)
+// SlidingPivot returns a YearPivot that picks the century placing a two-digit
+// year at most ahead years after the current Jalali year, as reported by Now
+// when the year is expanded. With ahead set to 20 in 1403, 23 means 1423
+// and 24 means 1324.
+func SlidingPivot(ahead int) YearPivot {
+	return SlidingPivotFrom(SystemClock, ahead)
+}
+
+// SlidingPivotFrom is like SlidingPivot but reads the current year from c.
+// A nil c means SystemClock.
+func SlidingPivotFrom(c Clock, ahead int) YearPivot {
+	if c == nil {
+		c = SystemClock
 	}
-	valueParts, valueSeps, err := tokenize(value)
-	if err != nil {
-		return JalaliDateTime{}, fmt.Errorf("invalid value: %v", err)
+	return func(yy int) int {
+		limit := NowFrom(c).Year() + ahead
+		year := limit - limit%100 + yy
+		if year > limit {
+			year -= 100
+		}
+		return year
 	}
+}
 
-	if len(layoutParts) != len(valueParts) {
-		return JalaliDateTime{}, errors.New("layout and value have mismatch structure")
+// Parse parses the value according to layout using the parser's options.
+// Fields missing from the layout default to 1 for year, month and day and
+// to 0 for the time of day, so "HH:MM" gives a time on 0001/01/01. Year 1
+// is a leap year, so a layout without a year, such as "MM/DD", accepts
+// 30 Esfand.
+//
+// Any error returned is a *ParseError describing the offending element.
+func (p Parser) Parse(layout, value string) (JalaliDateTime, error) {
+	if value == "" {
+		return JalaliDateTime{}, &ParseError{Layout: layout, Kind: Mismatch, Message: "empty value"}
 	}
 
-	for i, ls := range layoutSeps {
-		if ls != valueSeps[i] {
-			return JalaliDateTime{}, fmt.Errorf("separator mismatch at position %d: expected %c, got %c", i, ls, valueSeps[i])
+	year, month, day, hour, min, sec := 1, 1, 1, 0, 0, 0
+	offset, zoneSet := 0, false
+	var dayErr *ParseError
+	pos := 0
+	for _, e := range splitLayout(layout) {
+		switch e.kind {
+		case elemLiteral:
+			if !strings.HasPrefix(value[pos:], e.text) {
+				return JalaliDateTime{}, &ParseError{
+					Layout:     layout,
+					Value:      value,
+					LayoutElem: e.text,
+					ValueElem:  value[pos:],
+					Offset:     pos,
+					Kind:       Mismatch,
+				}
+			}
+			pos += len(e.text)
+			continue
+		case elemMonthName, elemMonthAbbr:
+			m, n := lookupMonth(value[pos:], e.kind == elemMonthAbbr, p.Locale)
+			if n == 0 {
+				return JalaliDateTime{}, &ParseError{
+					Layout:     layout,
+					Value:      value,
+					LayoutElem: e.text,
+					ValueElem:  value[pos:],
+					Offset:     pos,
+					Kind:       Mismatch,
+				}
+			}
+			month = m
+			pos += n
+			continue
+		case elemWeekday, elemWeekdayAbbr:
+			// Like time.Parse, the weekday is checked for syntax only.
+			n := lookupWeekday(value[pos:], e.kind == elemWeekdayAbbr, p.Locale)
+			if n == 0 {
+				return JalaliDateTime{}, &ParseError{
+					Layout:     layout,
+					Value:      value,
+					LayoutElem: e.text,
+					ValueElem:  value[pos:],
+					Offset:     pos,
+					Kind:       Mismatch,
+				}
+			}
+			pos += n
+			continue
+		case elemZone:
+			off, n, ok := readZoneOffset(value[pos:])
+			if !ok {
+				return JalaliDateTime{}, &ParseError{
+					Layout:     layout,
+					Value:      value,
+					LayoutElem: e.text,
+					ValueElem:  value[pos : pos+n],
+					Offset:     pos,
+					Kind:       Mismatch,
+				}
+			}
+			offset, zoneSet = off, true
+			pos += n
+			continue
+		case elemUnknown:
+			return JalaliDateTime{}, &ParseError{
+				Layout:     layout,
+				Value:      value,
+				LayoutElem: e.text,
+				ValueElem:  value[pos:],
+				Offset:     pos,
+				Kind:       UnknownElement,
+				Message:    "unknown layout element " + e.text,
+			}
 		}
-	}
 
-	var year, month, day, hour, min, sec int
-	for i, part := range layoutParts {
-		num, err := strconv.Atoi(valueParts[i])
-		if err != nil {
-			return JalaliDateTime{}, fmt.Errorf("invalid value for part << %v >> : %v", part, err)
+		num, n, ok := readDigits(value[pos:], e.minDigits, e.maxDigits)
+		if !ok {
+			return JalaliDateTime{}, &ParseError{
+				Layout:     layout,
+				Value:      value,
+				LayoutElem: e.text,
+				ValueElem:  value[pos : pos+n],
+				Offset:     pos,
+				Kind:       Mismatch,
+			}
 		}
-		switch part {
-		case "YYYY":
+		rangeErr := func(msg string) *ParseError {
+			return &ParseError{
+				Layout:     layout,
+				Value:      value,
+				LayoutElem: e.text,
+				ValueElem:  value[pos : pos+n],
+				Offset:     pos,
+				Kind:       OutOfRange,
+				Message:    msg,
+			}
+		}
+		switch e.kind {
+		case elemYear:
 			if num < 1 || num > 9999 {
-				return JalaliDateTime{}, errors.New("year out of range (1-9999)")
+				return JalaliDateTime{}, rangeErr("year out of range (1-9999)")
 			}
 			year = num
-		case "MM":
-			if i > 0 && layoutParts[i-1] == "HH" {
-				if num < 0 || num > 59 {
-					return JalaliDateTime{}, errors.New("minute out of range (0-59)")
-				}
-				min = num
-			} else {
-				if num < 1 || num > 12 {
-					return JalaliDateTime{}, errors.New("month out of range (1-12)")
-				}
-				month = num
+		case elemYear2:
+			pivot := p.Pivot
+			if pivot == nil {
+				pivot = SlidingPivotFrom(p.Clock, 20)
 			}
-		case "DD":
+			year = pivot(num)
+			if year < 1 || year > 9999 {
+				return JalaliDateTime{}, rangeErr("year out of range (1-9999)")
+			}
+		case elemMonth:
+			if num < 1 || num > 12 {
+				return JalaliDateTime{}, rangeErr("month out of range (1-12)")
+			}
+			month = num
+		case elemDay:
 			if num < 1 || num > 31 {
-				return JalaliDateTime{}, errors.New("day out of range (1-31)")
+				return JalaliDateTime{}, rangeErr("day out of range (1-31)")
 			}
 			day = num
-		case "HH":
+			dayErr = rangeErr("day out of range for month")
+		case elemHour:
 			if num < 0 || num > 23 {
-				return JalaliDateTime{}, errors.New("hour out of range (0-23)")
+				return JalaliDateTime{}, rangeErr("hour out of range (0-23)")
 			}
 			hour = num
-		case "SS":
+		case elemMinute:
+			if num < 0 || num > 59 {
+				return JalaliDateTime{}, rangeErr("minute out of range (0-59)")
+			}
+			min = num
+		case elemSecond:
 			if num < 0 || num > 59 {
-				return JalaliDateTime{}, errors.New("seconds out of range (0-59)")
+				return JalaliDateTime{}, rangeErr("seconds out of range (0-59)")
 			}
 			sec = num
+		}
+		pos += n
+	}
+	if pos < len(value) {
+		return JalaliDateTime{}, &ParseError{
+			Layout:    layout,
+			Value:     value,
+			ValueElem: value[pos:],
+			Offset:    pos,
+			Kind:      Mismatch,
+			Message:   "extra text: " + value[pos:],
+		}
+	}
+
+	if day > daysInMonth(year, Month(month)) {
+		if !p.Lenient {
+			return JalaliDateTime{}, dayErr
+		}
+		year, month, day = normalizeDay(year, month, day)
+		if year > 9999 {
+			// The day carried into a year no JalaliDateTime can hold.
+			err := *dayErr
+			err.Message = "date out of range (years 1-9999)"
+			return JalaliDateTime{}, &err
+		}
+	}
+
+	j := newJalali(year, Month(month), day, hour, min, sec, 0, p.Location)
+	// An offset in the value wins over the parser's location unless the
+	// two agree, in which case the named location is kept.
+	if zoneSet {
+		w := j.wallTimes()
+		_, early := w.earlier.Zone()
+		_, late := w.later.Zone()
+		switch {
+		case !j.IsNonexistent() && offset == early:
+			j.t = w.earlier
+		case w.overlap && offset == late:
+			j.t = w.later
 		default:
-			return JalaliDateTime{}, fmt.Errorf("invalid layout token at position %d: %s", i, part)
+			j = newJalali(year, Month(month), day, hour, min, sec, 0, time.FixedZone("", offset))
 		}
+		return j, nil
 	}
-	return JalaliDateTime{
-		year:     year,
-		month:    Month(month),
-		day:      day,
-		hour:     hour,
-		min:      min,
-		sec:      sec,
-		nanosec:  0,
-		location: location,
-	}, nil
+	resolved, err := j.Resolve(p.WallTime)
+	if err != nil {
+		msg := "nonexistent wall time in "
+		if errors.Is(err, ErrAmbiguousTime) {
+			msg = "ambiguous wall time in "
+		}
+		return JalaliDateTime{}, &ParseError{
+			Layout:  layout,
+			Value:   value,
+			Kind:    OutOfRange,
+			Message: msg + j.ToTime().Location().String(),
+		}
+	}
+	return resolved, nil
 }
 
-func tokenize(s string) (parts []string, seps []rune, err error) {
-	var sb strings.Builder
-	for i, r := range s {
-		if r == '/' || r == ':' || r == '-' || r == ' ' {
-			if sb.Len() > 0 {
-				parts = append(parts, sb.String())
-				sb.Reset()
-			} else if i > 0 {
-				return nil, nil, errors.New("consecutive separators")
+// normalizeDay carries a day that overflows its month into the following
+// months, rolling over into the next year after Esfand.
+func normalizeDay(year, month, day int) (int, int, int) {
+	for day > daysInMonth(year, Month(month)) {
+		day -= daysInMonth(year, Month(month))
+		month++
+		if month > 12 {
+			month = 1
+			year++
+		}
+	}
+	return year, month, day
+}
+
+// elemKind identifies what a layout element matches.
+type elemKind int
+
+const (
+	elemLiteral elemKind = iota
+	elemUnknown
+	elemYear
+	elemYear2
+	elemMonth
+	elemMonthName
+	elemMonthAbbr
+	elemDay
+	elemHour
+	elemMinute
+	elemSecond
+	elemWeekday
+	elemWeekdayAbbr
+	elemZone
+)
+
+// layoutElem is a single element of a parse layout: a field such as "YYYY"
+// or "%m", or literal text that must appear in the value unchanged.
+type layoutElem struct {
+	text      string // the element as written in the layout
+	kind      elemKind
+	minDigits int
+	maxDigits int
+}
+
+func isSeparator(c byte) bool {
+	return c == '/' || c == ':' || c == '-' || c == ' '
+}
+
+// splitLayout breaks a layout into elements. Layouts containing '%' use the
+// same specifiers as Format; anything else is read as YYYY/MM/DD style tokens.
+func splitLayout(layout string) []layoutElem {
+	if strings.IndexByte(layout, '%') >= 0 {
+		return splitSpecifierLayout(layout)
+	}
+
+	var elems []layoutElem
+	prev := ""
+	token := func(text string) {
+		e := layoutElem{text: text, minDigits: len(text), maxDigits: len(text)}
+		switch text {
+		case "YYYY":
+			e.kind = elemYear
+		case "YY":
+			e.kind = elemYear2
+		case "MM":
+			e.kind = elemMonth
+			if prev == "HH" {
+				e.kind = elemMinute
+			}
+		case "DD":
+			e.kind = elemDay
+		case "HH":
+			e.kind = elemHour
+		case "SS":
+			e.kind = elemSecond
+		default:
+			e.kind = elemUnknown
+		}
+		elems = append(elems, e)
+		prev = text
+	}
+	start := 0
+	for i := 0; i < len(layout); i++ {
+		if !isSeparator(layout[i]) {
+			continue
+		}
+		if i > start {
+			token(layout[start:i])
+		}
+		elems = append(elems, layoutElem{text: layout[i : i+1], kind: elemLiteral})
+		start = i + 1
+	}
+	if start < len(layout) {
+		token(layout[start:])
+	}
+	return elems
+}
+
+// splitSpecifierLayout breaks a Format style layout such as "%Y/%m/%d" into
+// elements. Numeric fields accept one or two digits, except %Y which needs
+// four and %y which needs two.
+func splitSpecifierLayout(layout string) []layoutElem {
+	var elems []layoutElem
+	literal := func(text string) {
+		if n := len(elems); n > 0 && elems[n-1].kind == elemLiteral {
+			elems[n-1].text += text
+			return
+		}
+		elems = append(elems, layoutElem{text: text, kind: elemLiteral})
+	}
+	field := func(text string, kind elemKind, minDigits, maxDigits int) {
+		elems = append(elems, layoutElem{text: text, kind: kind, minDigits: minDigits, maxDigits: maxDigits})
+	}
+
+	for i := 0; i < len(layout); i++ {
+		if layout[i] != '%' || i+1 == len(layout) {
+			literal(layout[i : i+1])
+			continue
+		}
+		i++
+		spec := layout[i-1 : i+1]
+		switch layout[i] {
+		case 'Y':
+			field(spec, elemYear, 4, 4)
+		case 'y':
+			field(spec, elemYear2, 2, 2)
+		case 'm':
+			field(spec, elemMonth, 1, 2)
+		case 'B':
+			field(spec, elemMonthName, 0, 0)
+		case 'b':
+			field(spec, elemMonthAbbr, 0, 0)
+		case 'd':
+			field(spec, elemDay, 1, 2)
+		case 'w':
+			field(spec, elemWeekday, 0, 0)
+		case 'a':
+			field(spec, elemWeekdayAbbr, 0, 0)
+		case 'z':
+			field(spec, elemZone, 0, 0)
+		case 'H':
+			field(spec, elemHour, 1, 2)
+		case 'M':
+			field(spec, elemMinute, 1, 2)
+		case 'S':
+			field(spec, elemSecond, 1, 2)
+		case 'R':
+			field(spec, elemHour, 1, 2)
+			literal(":")
+			field(spec, elemMinute, 1, 2)
+		case 'T':
+			field(spec, elemHour, 1, 2)
+			literal(":")
+			field(spec, elemMinute, 1, 2)
+			literal(":")
+			field(spec, elemSecond, 1, 2)
+		case '%':
+			literal("%")
+		case 'n':
+			literal("\n")
+		default:
+			field(spec, elemUnknown, 0, 0)
+		}
+	}
+	return elems
+}
+
+// digitValue returns the value of an ASCII, Persian or Arabic-Indic digit,
+// or -1 if r is not a digit.
+func digitValue(r rune) int {
+	switch {
+	case r >= '0' && r <= '9':
+		return int(r - '0')
+	case r >= '۰' && r <= '۹':
+		return int(r - '۰')
+	case r >= '٠' && r <= '٩':
+		return int(r - '٠')
+	}
+	return -1
+}
+
+// readDigits reads between minDigits and maxDigits digits from the start of s.
+// It returns the number, how many bytes belong to the element and whether
+// enough digits were found.
+func readDigits(s string, minDigits, maxDigits int) (num, n int, ok bool) {
+	count := 0
+	for n < len(s) && count < maxDigits {
+		r, size := utf8.DecodeRuneInString(s[n:])
+		d := digitValue(r)
+		if d < 0 {
+			if count < minDigits && !isSeparator(s[n]) {
+				return 0, n + size, false
+			}
+			break
+		}
+		num = num*10 + d
+		n += size
+		count++
+	}
+	return num, n, count >= minDigits
+}
+
+// lookupMonth matches a month name of the locale at the start of s,
+// preferring the longest match. Latin names match case-insensitively.
+// A nil locale accepts both the Persian and the transliterated names.
+func lookupMonth(s string, abbr bool, l *Locale) (month, n int) {
+	for m := Farvardin; m <= Esfand; m++ {
+		var names []string
+		switch {
+		case l == nil && abbr:
+			names = []string{abbreviate(FaJalaliMonthName[m]), abbreviate(EnJalaliMonthName[m])}
+		case l == nil:
+			names = []string{FaJalaliMonthName[m], EnJalaliMonthName[m]}
+		case abbr:
+			names = []string{l.ShortMonthName(m)}
+		default:
+			names = []string{l.MonthName(m)}
+		}
+		for _, name := range names {
+			if name != "" && len(name) > n && len(name) <= len(s) && strings.EqualFold(s[:len(name)], name) {
+				month, n = int(m), len(name)
+			}
+		}
+	}
+	return month, n
+}
+
+// lookupWeekday matches a weekday name of the locale at the start of s and
+// returns its length, preferring the longest match. A nil locale accepts
+// the Persian and transliterated names.
+func lookupWeekday(s string, abbr bool, l *Locale) (n int) {
+	for w := Yekshanbe; w <= Shanbe; w++ {
+		var names []string
+		switch {
+		case l == nil && abbr:
+			names = []string{FaShortWeekDays[w]}
+		case l == nil:
+			names = []string{FaWeekDays[w], FinglishWeekDays[w]}
+		case abbr:
+			names = []string{l.ShortWeekdayName(w)}
+		default:
+			names = []string{l.WeekdayName(w)}
+		}
+		for _, name := range names {
+			if name != "" && len(name) > n && len(name) <= len(s) && strings.EqualFold(s[:len(name)], name) {
+				n = len(name)
 			}
-			seps = append(seps, r)
-		} else {
-			sb.WriteRune(r)
 		}
 	}
-	if sb.Len() > 0 {
-		parts = append(parts, sb.String())
+	return n
+}
+
+// readZoneOffset reads a UTC offset such as "+0330", "-04:30" or "Z" from
+// the start of s. It returns the offset in seconds and the number of bytes
+// read.
+func readZoneOffset(s string) (offset, n int, ok bool) {
+	if s == "" {
+		return 0, 0, false
+	}
+	if s[0] == 'Z' {
+		return 0, 1, true
+	}
+	if s[0] != '+' && s[0] != '-' {
+		_, size := utf8.DecodeRuneInString(s)
+		return 0, size, false
 	}
-	if len(parts) == 0 {
-		return nil, nil, errors.New("empty input")
+	hh, hn, ok := readDigits(s[1:], 2, 2)
+	n = 1 + hn
+	if !ok {
+		return 0, n, false
 	}
-	return parts, seps, nil
-}
\ No newline at end of file
+	if n < len(s) && s[n] == ':' {
+		n++
+	}
+	mm, mn, ok := readDigits(s[n:], 2, 2)
+	n += mn
+	if !ok || hh > 23 || mm > 59 {
+		return 0, n, false
+	}
+	offset = hh*3600 + mm*60
+	if s[0] == '-' {
+		offset = -offset
+	}
+	return offset, n, true
+}
+
+// abbreviate returns the first three characters of a name, as used by %b.
+func abbreviate(name string) string {
+	r := []rune(name)
+	if len(r) > 3 {
+		r = r[:3]
+	}
+	return string(r)
+}
@@ -1,7 +1,9 @@
 package golali_test
 
 import (
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/bijanghanei/golali"
 )
@@ -57,4 +59,259 @@ func TestParseErrors(t *testing.T) {
 			t.Errorf("Parse(%q, %q) should fail but succeeded", tt.layout, tt.value)
 		}
 	}
-}
\ No newline at end of file
+}
+
+func TestParseErrorDetails(t *testing.T) {
+	tests := []struct {
+		layout, value string
+		kind          golali.ParseErrorKind
+		layoutElem    string
+		valueElem     string
+		offset        int
+	}{
+		{"YYYY/MM/DD", "1403/13/01", golali.OutOfRange, "MM", "13", 5},
+		{"YYYY/MM/DD", "1403-06-15", golali.Mismatch, "/", "-06-15", 4},
+		{"YYYY/MM/DD", "1403/0x/15", golali.Mismatch, "MM", "0x", 5},
+		{"YYYY/MM/DD", "1403/06/", golali.Mismatch, "DD", "", 8},
+		{"YYYY/MM/DD", "1403/06/155", golali.Mismatch, "", "5", 10},
+		{"YYYY/MM/QQ", "1403/06/15", golali.UnknownElement, "QQ", "15", 8},
+		{"YYYY/MM/DD HH:MM", "1403/06/15 10:75", golali.OutOfRange, "MM", "75", 14},
+	}
+
+	for _, tt := range tests {
+		_, err := golali.Parse(tt.layout, tt.value)
+		var pe *golali.ParseError
+		if !errors.As(err, &pe) {
+			t.Fatalf("Parse(%q, %q) error = %v, want *ParseError", tt.layout, tt.value, err)
+		}
+		if pe.Kind != tt.kind || pe.LayoutElem != tt.layoutElem || pe.ValueElem != tt.valueElem || pe.Offset != tt.offset {
+			t.Errorf("Parse(%q, %q) = {Kind: %v, LayoutElem: %q, ValueElem: %q, Offset: %d}, want {%v, %q, %q, %d}",
+				tt.layout, tt.value, pe.Kind, pe.LayoutElem, pe.ValueElem, pe.Offset,
+				tt.kind, tt.layoutElem, tt.valueElem, tt.offset)
+		}
+		if pe.Layout != tt.layout || pe.Value != tt.value {
+			t.Errorf("ParseError does not carry layout and value: %+v", pe)
+		}
+	}
+}
+
+func TestParseErrorMessage(t *testing.T) {
+	_, err := golali.Parse("YYYY/MM/DD", "1403/0x/15")
+	want := `parsing time "1403/0x/15" as "YYYY/MM/DD": cannot parse "0x" as "MM"`
+	if err == nil || err.Error() != want {
+		t.Errorf("error = %v, want %s", err, want)
+	}
+
+	_, err = golali.Parse("YYYY/MM/DD", "1403/13/01")
+	want = `parsing time "1403/13/01": month out of range (1-12)`
+	if err == nil || err.Error() != want {
+		t.Errorf("error = %v, want %s", err, want)
+	}
+}
+
+func TestParseValidatesMonthLength(t *testing.T) {
+	badCases := []string{
+		"1404/12/30", // Esfand of a common year
+		"1403/07/31", // Mehr has 30 days
+		"1402/11/31", // Bahman has 30 days
+	}
+	for _, value := range badCases {
+		_, err := golali.Parse("YYYY/MM/DD", value)
+		var pe *golali.ParseError
+		if !errors.As(err, &pe) || pe.Kind != golali.OutOfRange || pe.LayoutElem != "DD" || pe.Offset != 8 {
+			t.Errorf("Parse(%q) error = %v, want OutOfRange on DD", value, err)
+		}
+	}
+
+	if _, err := golali.Parse("YYYY/MM/DD", "1403/12/30"); err != nil {
+		t.Errorf("Parse of leap Esfand 30 failed: %v", err)
+	}
+}
+
+func TestParseLenient(t *testing.T) {
+	p := golali.Parser{Location: time.UTC, Lenient: true}
+	tests := []struct {
+		value string
+		year  int
+		month golali.Month
+		day   int
+	}{
+		{"1404/12/30", 1405, golali.Farvardin, 1},
+		{"1403/07/31", 1403, golali.Aban, 1},
+		{"1403/12/30", 1403, golali.Esfand, 30},
+		{"1403/06/31", 1403, golali.Shahrivar, 31},
+	}
+	for _, tt := range tests {
+		j, err := p.Parse("YYYY/MM/DD", tt.value)
+		if err != nil {
+			t.Fatalf("lenient Parse(%q) failed: %v", tt.value, err)
+		}
+		if j.Year() != tt.year || j.Month() != tt.month || j.Day() != tt.day {
+			t.Errorf("lenient Parse(%q) = %v, want %d/%d/%d", tt.value, j, tt.year, tt.month, tt.day)
+		}
+		if j.Location() != time.UTC {
+			t.Errorf("lenient Parse(%q) location = %v, want UTC", tt.value, j.Location())
+		}
+	}
+
+	// Rolling over past the last supported year is reported, not returned.
+	_, err := p.Parse("YYYY/MM/DD", "9999/12/31")
+	var pe *golali.ParseError
+	if !errors.As(err, &pe) || pe.Kind != golali.OutOfRange {
+		t.Errorf("lenient Parse(%q) error = %v, want OutOfRange", "9999/12/31", err)
+	}
+}
+
+func TestParseMissingYear(t *testing.T) {
+	tests := []struct {
+		layout, value string
+		lenient       bool
+		want          string
+	}{
+		{"HH:MM", "10:20", false, "0001/01/01 10:20:00"},
+		{golali.TimeOnly, "10:20:30", false, "0001/01/01 10:20:30"},
+		{"MM/DD", "07/15", false, "0001/07/15 00:00:00"},
+		{"MM/DD", "12/30", false, "0001/12/30 00:00:00"},
+		{"MM/DD", "12/30", true, "0001/12/30 00:00:00"},
+	}
+	for _, tt := range tests {
+		j, err := golali.Parser{Location: time.UTC, Lenient: tt.lenient}.Parse(tt.layout, tt.value)
+		if err != nil {
+			t.Errorf("Parse(%q, %q): %v", tt.layout, tt.value, err)
+			continue
+		}
+		if got := j.String(); got != tt.want {
+			t.Errorf("Parse(%q, %q) = %s, want %s", tt.layout, tt.value, got, tt.want)
+		}
+		if !j.Equal(golali.Date(j.Year(), j.Month(), j.Day(), j.Hour(), j.Minute(), j.Second(), 0, time.UTC)) {
+			t.Errorf("Parse(%q, %q) = %v is not a valid date", tt.layout, tt.value, j)
+		}
+	}
+}
+
+func TestParseSpecifierLayout(t *testing.T) {
+	tests := []struct {
+		layout, value string
+		year          int
+		month         golali.Month
+		day, hour     int
+	}{
+		{"%Y/%m/%d %H:%M", "1403/07/15 10:20", 1403, golali.Mehr, 15, 10},
+		{"%Y/%m/%d", "1403/7/5", 1403, golali.Mehr, 5, 0},
+		{"%Y%m%d", "14030715", 1403, golali.Mehr, 15, 0},
+		{"%d %B %Y", "۱۵ مهر ۱۴۰۳", 1403, golali.Mehr, 15, 0},
+		{"%d %B %Y", "15 mehr 1403", 1403, golali.Mehr, 15, 0},
+		{"%d %b %Y", "10 دی 1403", 1403, golali.Dey, 10, 0},
+		{"%Y-%m-%d %T", "۱۴۰۳-۰۷-۱۵ ۱۰:۲۰:۳۰", 1403, golali.Mehr, 15, 10},
+	}
+	for _, tt := range tests {
+		j, err := golali.ParseInLocation(tt.layout, tt.value, time.UTC)
+		if err != nil {
+			t.Fatalf("Parse(%q, %q) failed: %v", tt.layout, tt.value, err)
+		}
+		if j.Year() != tt.year || j.Month() != tt.month || j.Day() != tt.day || j.Hour() != tt.hour {
+			t.Errorf("Parse(%q, %q) = %v", tt.layout, tt.value, j)
+		}
+	}
+
+	_, err := golali.Parse("%Y/%m/%d", "1403/07/x3")
+	var pe *golali.ParseError
+	if !errors.As(err, &pe) || pe.LayoutElem != "%d" || pe.ValueElem != "x" {
+		t.Errorf("Parse error = %v, want mismatch on %%d", err)
+	}
+}
+
+func TestParseAny(t *testing.T) {
+	tests := []struct {
+		value string
+		year  int
+		month golali.Month
+		day   int
+		hour  int
+		min   int
+	}{
+		{"1403/07/15", 1403, golali.Mehr, 15, 0, 0},
+		{"15/07/1403", 1403, golali.Mehr, 15, 0, 0},
+		{"1403-7-15", 1403, golali.Mehr, 15, 0, 0},
+		{"۱۴۰۳-۰۷-۱۵ ۱۰:۲۰", 1403, golali.Mehr, 15, 10, 20},
+		{"۱۵ مهر ۱۴۰۳", 1403, golali.Mehr, 15, 0, 0},
+		{"  15  Mehr 1403 ", 1403, golali.Mehr, 15, 0, 0},
+		{"۱۵ مهر ۱۴۰۳ ۰۸:۰۵", 1403, golali.Mehr, 15, 8, 5},
+		{"10 دي 1402", 1402, golali.Dey, 10, 0, 0}, // Arabic yeh
+		{"15/07/75", 1375, golali.Mehr, 15, 0, 0},  // 75 can only be a year
+		{"75/07/15", 1375, golali.Mehr, 15, 0, 0},
+	}
+	for _, tt := range tests {
+		j, err := golali.ParseAny(tt.value, time.UTC)
+		if err != nil {
+			t.Errorf("ParseAny(%q) failed: %v", tt.value, err)
+			continue
+		}
+		if j.Year() != tt.year || j.Month() != tt.month || j.Day() != tt.day ||
+			j.Hour() != tt.hour || j.Minute() != tt.min {
+			t.Errorf("ParseAny(%q) = %v", tt.value, j)
+		}
+	}
+
+	_, err := golali.ParseAny("03/07/15", time.UTC)
+	var pe *golali.ParseError
+	if !errors.As(err, &pe) || pe.Kind != golali.Ambiguous {
+		t.Errorf("ParseAny(03/07/15) error = %v, want Ambiguous", err)
+	}
+
+	_, err = golali.ParseAny("not a date", time.UTC)
+	if !errors.As(err, &pe) || pe.Kind != golali.Mismatch {
+		t.Errorf("ParseAny(not a date) error = %v, want Mismatch", err)
+	}
+}
+
+func TestParseTwoDigitYear(t *testing.T) {
+	fixed := golali.Parser{Location: time.UTC, Pivot: golali.FixedPivot(50)}
+	tests := []struct {
+		layout, value string
+		year          int
+	}{
+		{"YY/MM/DD", "03/07/15", 1403},
+		{"YY/MM/DD", "49/01/01", 1449},
+		{"YY/MM/DD", "50/01/01", 1350},
+		{"%y/%m/%d", "99/12/29", 1399},
+		{"%d/%m/%y", "15/07/۰۳", 1403},
+	}
+	for _, tt := range tests {
+		j, err := fixed.Parse(tt.layout, tt.value)
+		if err != nil {
+			t.Fatalf("Parse(%q, %q) failed: %v", tt.layout, tt.value, err)
+		}
+		if j.Year() != tt.year {
+			t.Errorf("Parse(%q, %q) year = %d, want %d", tt.layout, tt.value, j.Year(), tt.year)
+		}
+	}
+
+	// The default pivot looks at most 20 years past the current year,
+	// 1403 on this clock.
+	clock := golali.NewManualClock(golali.Date(1403, golali.Mehr, 1, 0, 0, 0, 0, time.UTC).ToTime())
+	sliding := golali.Parser{Location: time.UTC, Clock: clock}
+	for _, tt := range []struct {
+		value string
+		year  int
+	}{
+		{"23/01/01", 1423},
+		{"24/01/01", 1324},
+		{"03/01/01", 1403},
+	} {
+		j, err := sliding.Parse("YY/MM/DD", tt.value)
+		if err != nil || j.Year() != tt.year {
+			t.Errorf("default pivot parsed %q as %v, %v, want year %d", tt.value, j, err, tt.year)
+		}
+	}
+	// The year is read from the clock at each parse.
+	clock.Set(golali.Date(1404, golali.Farvardin, 1, 0, 0, 0, 0, time.UTC).ToTime())
+	if j, err := sliding.Parse("YY/MM/DD", "24/01/01"); err != nil || j.Year() != 1424 {
+		t.Errorf("default pivot after a new year = %v, %v, want year 1424", j, err)
+	}
+
+	custom := golali.Parser{Pivot: func(yy int) int { return 1300 + yy }}
+	if j, err := custom.ParseAny("15/07/45"); err != nil || j.Year() != 1345 {
+		t.Errorf("ParseAny with custom pivot = %v, %v, want year 1345", j, err)
+	}
+}
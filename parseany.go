@@ -0,0 +1,86 @@
+package golali
+
+import (
+	"strings"
+	"time"
+)
+
+// anyDateLayouts are the date layouts tried by ParseAny, in order.
+var anyDateLayouts = []string{
+	"%Y/%m/%d", "%Y-%m-%d", "%Y.%m.%d", "%Y%m%d",
+	"%d/%m/%Y", "%d-%m-%Y", "%d.%m.%Y",
+	"%y/%m/%d", "%y-%m-%d", "%y.%m.%d",
+	"%d/%m/%y", "%d-%m-%y", "%d.%m.%y",
+	"%d %B %Y", "%B %d, %Y", "%d %b %Y",
+}
+
+// anyTimeLayouts are the time suffixes tried after each date layout.
+var anyTimeLayouts = []string{"", " %H:%M", " %H:%M:%S", "T%H:%M:%S"}
+
+// anyReplacer folds Arabic letters that are often typed in place of their
+// Persian counterparts and unifies the Persian decimal separator.
+var anyReplacer = strings.NewReplacer("ي", "ی", "ك", "ک", "٫", ".", "٬", ",", "،", ",")
+
+// ParseAny parses a date in any of the common Jalali notations, such as
+// "1403/07/15", "15/07/1403", "1403-07-15 10:20", "۱۴۰۳/۰۷/۱۵" or
+// "۱۵ مهر ۱۴۰۳", and returns it in the given location.
+//
+// Every known layout is tried. The position of the year is recognised by its
+// magnitude: a four-digit number is always the year, and a two-digit number
+// greater than 31 cannot be a day. Two-digit years are expanded with the
+// default pivot described at Parser.Pivot. When more than one layout
+// accepts the value with different results, as with "03/07/15", ParseAny
+// returns a ParseError of kind Ambiguous instead of picking one; callers can
+// then parse with an explicit layout.
+func ParseAny(value string, loc *time.Location) (JalaliDateTime, error) {
+	return Parser{Location: loc}.ParseAny(value)
+}
+
+// ParseAny is like the package-level ParseAny but uses the parser's options,
+// such as its location and two-digit year pivot.
+func (p Parser) ParseAny(value string) (JalaliDateTime, error) {
+	v := anyReplacer.Replace(strings.Join(strings.Fields(value), " "))
+
+	var found []JalaliDateTime
+	var layouts []string
+	for _, date := range anyDateLayouts {
+		for _, clock := range anyTimeLayouts {
+			j, err := p.Parse(date+clock, v)
+			if err != nil {
+				continue
+			}
+			seen := false
+			for _, f := range found {
+				if f == j {
+					seen = true
+					break
+				}
+			}
+			if !seen {
+				found = append(found, j)
+				layouts = append(layouts, date+clock)
+			}
+		}
+	}
+
+	switch len(found) {
+	case 0:
+		return JalaliDateTime{}, &ParseError{
+			Value:   value,
+			Kind:    Mismatch,
+			Message: "value does not match any known layout",
+		}
+	case 1:
+		return found[0], nil
+	}
+	candidates := make([]string, len(found))
+	for i, f := range found {
+		candidates[i] = f.Format("%Y/%m/%d")
+	}
+	return JalaliDateTime{}, &ParseError{
+		Layout:  strings.Join(layouts, " | "),
+		Value:   value,
+		Kind:    Ambiguous,
+		Message: "ambiguous date, could be any of " + strings.Join(candidates, ", "),
+	}
+}
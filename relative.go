@@ -0,0 +1,438 @@
+package golali
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ParseRelative resolves a relative date expression against ref and returns
+// the absolute date it denotes. Persian, Finglish (Persian written in Latin
+// letters) and English are understood, with Persian or ASCII digits:
+//
+//	امروز فردا پس‌فردا دیروز پریروز الان   today tomorrow yesterday now
+//	۳ روز پیش، ۲ هفته بعد، ۱ ماه دیگر      3 days ago, in 2 weeks, 5 hours later
+//	هفته بعد، ماه آینده، سال گذشته         next week, last month, this year
+//	اول ماه آینده، آخر سال، اول هفته       start of next month, end of the year
+//	جمعه، جمعه آینده، شنبه گذشته           Friday, next Friday, last Saturday
+//	۱۵ مهر، ۱ فروردین ۱۴۰۴، اول مهر         15 Mehr, mehr next, end of Esfand
+//
+// Units are seconds, minutes, hours, days, weeks, months and years; a number
+// may be written in digits or as a word from one to ten. A weekday on its
+// own, or with "next", means its next occurrence after ref; with "last" its
+// previous one; with "this" the one in the week of ref, which starts on
+// Shanbe. A month name with "next" or "last" means the first day of its next
+// or previous occurrence.
+//
+// Expressions that move by days or larger units keep the time of day of ref.
+// If text is not understood a ParseError of kind Mismatch is returned, and if
+// the date it denotes is outside the years 1-9999 one of kind OutOfRange.
+func ParseRelative(text string, ref JalaliDateTime) (JalaliDateTime, error) {
+	j, ok := resolveRelative(relativeWords(text), ref)
+	if !ok {
+		return JalaliDateTime{}, &ParseError{
+			Value:   text,
+			Kind:    Mismatch,
+			Message: "unrecognised relative date expression",
+		}
+	}
+	if !inYearRange(j) {
+		return JalaliDateTime{}, &ParseError{
+			Value:   text,
+			Kind:    OutOfRange,
+			Message: "relative date out of range (years 1-9999)",
+		}
+	}
+	return j, nil
+}
+
+// inYearRange reports whether j is in the years 1-9999 accepted by Date.
+func inYearRange(j JalaliDateTime) bool {
+	return j.year >= 1 && j.year <= 9999
+}
+
+type relUnit int
+
+const (
+	relSecond relUnit = iota + 1
+	relMinute
+	relHour
+	relDay
+	relWeek
+	relMonth
+	relYear
+)
+
+var relDayWords = map[string]int{
+	"امروز": 0, "today": 0, "emrooz": 0, "emruz": 0,
+	"فردا": 1, "tomorrow": 1, "farda": 1,
+	"پسفردا": 2, "pasfarda": 2,
+	"دیروز": -1, "yesterday": -1, "dirooz": -1, "diruz": -1,
+	"پریروز": -2, "parirooz": -2, "pariruz": -2,
+	"الان": 0, "اکنون": 0, "now": 0, "alan": 0,
+}
+
+var relUnits = map[string]relUnit{
+	"ثانیه": relSecond, "second": relSecond, "seconds": relSecond, "saniye": relSecond,
+	"دقیقه": relMinute, "minute": relMinute, "minutes": relMinute, "daghighe": relMinute, "daqiqe": relMinute,
+	"ساعت": relHour, "hour": relHour, "hours": relHour, "saat": relHour,
+	"روز": relDay, "day": relDay, "days": relDay, "rooz": relDay, "ruz": relDay,
+	"هفته": relWeek, "week": relWeek, "weeks": relWeek, "hafte": relWeek,
+	"ماه": relMonth, "month": relMonth, "months": relMonth, "mah": relMonth,
+	"سال": relYear, "year": relYear, "years": relYear, "sal": relYear,
+}
+
+// relDirections maps direction words to -1 (past), 1 (future) or 0 (current).
+var relDirections = map[string]int{
+	"پیش": -1, "قبل": -1, "گذشته": -1, "ago": -1, "last": -1, "previous": -1,
+	"pish": -1, "ghabl": -1, "gozashte": -1,
+	"بعد": 1, "بعدی": 1, "دیگر": 1, "آینده": 1, "later": 1, "next": 1,
+	"bad": 1, "baad": 1, "badi": 1, "dige": 1, "digar": 1, "ayande": 1,
+	"این": 0, "جاری": 0, "همین": 0, "this": 0, "current": 0, "jari": 0,
+}
+
+var relNumbers = map[string]int{
+	"یک": 1, "دو": 2, "سه": 3, "چهار": 4, "پنج": 5, "شش": 6, "هفت": 7, "هشت": 8, "نه": 9, "ده": 10,
+	"a": 1, "an": 1, "one": 1, "two": 2, "three": 3, "four": 4, "five": 5,
+	"six": 6, "seven": 7, "eight": 8, "nine": 9, "ten": 10,
+	"yek": 1, "do": 2, "se": 3, "chahar": 4, "panj": 5, "shesh": 6, "haft": 7, "hasht": 8, "noh": 9, "dah": 10,
+}
+
+// relEdges maps words for the start (false) or end (true) of a period.
+var relEdges = map[string]bool{
+	"اول": false, "ابتدای": false, "ابتدا": false, "start": false, "beginning": false, "avval": false, "aval": false,
+	"آخر": true, "انتهای": true, "پایان": true, "end": true, "akhar": true,
+}
+
+// relWeekdays and relMonths are filled from the exported name tables.
+var (
+	relWeekdays = map[string]Weekday{
+		"sunday": Yekshanbe, "monday": Doshanbe, "tuesday": Seshanbe, "wednesday": Chaharshanbe,
+		"thursday": Panjshanbe, "friday": Joomeh, "saturday": Shanbe,
+		"yekshanbe": Yekshanbe, "doshanbe": Doshanbe, "seshanbe": Seshanbe, "chaharshanbe": Chaharshanbe,
+		"panjshanbe": Panjshanbe, "jome": Joomeh, "jomeh": Joomeh, "joomeh": Joomeh, "shanbe": Shanbe,
+	}
+	relMonths = map[string]Month{}
+)
+
+func init() {
+	for name, wd := range relWeekdays {
+		if strings.HasSuffix(name, "e") {
+			relWeekdays[name+"h"] = wd
+		}
+	}
+	for i := range FaWeekDays {
+		relWeekdays[normalizeRelative(FaWeekDays[i])] = Weekday(i)
+		relWeekdays[strings.ToLower(EnWeekDays[i])] = Weekday(i)
+	}
+	for m := Farvardin; m <= Esfand; m++ {
+		relMonths[normalizeRelative(FaJalaliMonthName[m])] = m
+		relMonths[strings.ToLower(EnJalaliMonthName[m])] = m
+	}
+}
+
+// relPhrases joins multi-word expressions into the single words used by the
+// lookup tables.
+var relPhrases = strings.NewReplacer(
+	"پس فردا", "پسفردا", "pas farda", "pasfarda",
+	"day after tomorrow", "pasfarda", "day before yesterday", "parirooz",
+	"from now", "later",
+	"یک شنبه", "یکشنبه", "دو شنبه", "دوشنبه", "سه شنبه", "سهشنبه",
+	"چهار شنبه", "چهارشنبه", "پنج شنبه", "پنجشنبه",
+)
+
+// normalizeRelative lowercases s, converts its digits to ASCII, removes
+// zero-width non-joiners and folds Arabic letters to their Persian forms.
+func normalizeRelative(s string) string {
+	s = strings.Map(func(r rune) rune {
+		if r == '‌' {
+			return -1
+		}
+		if d := digitValue(r); d >= 0 {
+			return '0' + rune(d)
+		}
+		return r
+	}, strings.ToLower(s))
+	s = anyReplacer.Replace(strings.Join(strings.Fields(s), " "))
+	return strings.Trim(relPhrases.Replace(s), " .,!?")
+}
+
+// relativeWords splits text into normalized words, dropping filler words.
+func relativeWords(text string) []string {
+	var words []string
+	for _, w := range strings.Fields(normalizeRelative(text)) {
+		if w != "of" && w != "the" {
+			words = append(words, w)
+		}
+	}
+	return words
+}
+
+func resolveRelative(w []string, ref JalaliDateTime) (JalaliDateTime, bool) {
+	if len(w) == 0 {
+		return JalaliDateTime{}, false
+	}
+	if len(w) == 1 {
+		if n, ok := relDayWords[w[0]]; ok {
+			return ref.AddDays(n), true
+		}
+	}
+	if end, ok := relEdges[w[0]]; ok {
+		if m, year, ok := relativeMonth(w[1:], ref); ok {
+			return monthEdge(ref, year, m, end), true
+		}
+		if unit, dir, ok := unitAndDirection(w[1:]); ok {
+			j := addRelative(ref, unit, dir)
+			if !inYearRange(j) {
+				return j, true
+			}
+			return periodEdge(j, unit, end)
+		}
+		return JalaliDateTime{}, false
+	}
+	if wd, dir, ok := weekdayAndDirection(w); ok {
+		return ref.AddDays(weekdayDelta(ref.Weekday(), wd, dir)), true
+	}
+	if day, ok := relativeNumber(w[0]); ok && len(w) >= 2 {
+		if m, ok := relMonths[w[1]]; ok {
+			return dayOfMonth(ref, day, m, w[2:])
+		}
+	}
+	if m, year, ok := relativeMonth(w, ref); ok {
+		return ref.withDate(year, m, 1), true
+	}
+	if n, unit, ok := offsetExpression(w); ok {
+		return addRelative(ref, unit, n), true
+	}
+	return JalaliDateTime{}, false
+}
+
+// offsetExpression recognises "N unit ago/later", "in N unit" and
+// "unit next/last".
+func offsetExpression(w []string) (n int, unit relUnit, ok bool) {
+	switch len(w) {
+	case 2:
+		u, dir, ok := unitAndDirection(w)
+		return dir, u, ok
+	case 3:
+		if w[0] == "in" {
+			n, ok := relativeNumber(w[1])
+			unit, uok := relUnits[w[2]]
+			return n, unit, ok && uok
+		}
+		n, ok := relativeNumber(w[0])
+		unit, uok := relUnits[w[1]]
+		dir, dok := relDirections[w[2]]
+		return n * dir, unit, ok && uok && dok && dir != 0
+	}
+	return 0, 0, false
+}
+
+// unitAndDirection recognises a unit on its own or together with a direction
+// word in either order, such as "ماه آینده" or "next month".
+func unitAndDirection(w []string) (unit relUnit, dir int, ok bool) {
+	switch len(w) {
+	case 1:
+		unit, ok = relUnits[w[0]]
+		return unit, 0, ok
+	case 2:
+		if unit, ok = relUnits[w[0]]; ok {
+			dir, ok = relDirections[w[1]]
+			return unit, dir, ok
+		}
+		if unit, ok = relUnits[w[1]]; ok {
+			dir, ok = relDirections[w[0]]
+			return unit, dir, ok
+		}
+	}
+	return 0, 0, false
+}
+
+// weekdayAndDirection recognises a weekday name with an optional direction
+// word before or after it. A bare weekday looks forward.
+func weekdayAndDirection(w []string) (wd Weekday, dir int, ok bool) {
+	switch len(w) {
+	case 1:
+		wd, ok = relWeekdays[w[0]]
+		return wd, 1, ok
+	case 2:
+		if wd, ok = relWeekdays[w[0]]; ok {
+			dir, ok = relDirections[w[1]]
+			return wd, dir, ok
+		}
+		if wd, ok = relWeekdays[w[1]]; ok {
+			dir, ok = relDirections[w[0]]
+			return wd, dir, ok
+		}
+	}
+	return 0, 0, false
+}
+
+// relativeMonth recognises a month name with an optional direction word and
+// returns the month together with the year of the occurrence it refers to.
+func relativeMonth(w []string, ref JalaliDateTime) (m Month, year int, ok bool) {
+	dir := 0
+	switch len(w) {
+	case 1:
+		m, ok = relMonths[w[0]]
+	case 2:
+		if m, ok = relMonths[w[0]]; ok {
+			dir, ok = relDirections[w[1]]
+		} else if m, ok = relMonths[w[1]]; ok {
+			dir, ok = relDirections[w[0]]
+		}
+	}
+	if !ok {
+		return 0, 0, false
+	}
+	year = ref.Year()
+	if dir > 0 && m <= ref.Month() {
+		year++
+	} else if dir < 0 && m >= ref.Month() {
+		year--
+	}
+	return m, year, true
+}
+
+// dayOfMonth resolves "15 Mehr", "15 مهر ماه" and "1 Farvardin 1404".
+func dayOfMonth(ref JalaliDateTime, day int, m Month, rest []string) (JalaliDateTime, bool) {
+	if len(rest) > 0 && rest[0] == "ماه" {
+		rest = rest[1:]
+	}
+	year := ref.Year()
+	switch len(rest) {
+	case 0:
+	case 1:
+		y, err := strconv.Atoi(rest[0])
+		if err != nil || y < 1 || y > 9999 {
+			return JalaliDateTime{}, false
+		}
+		year = y
+	default:
+		return JalaliDateTime{}, false
+	}
+	if day < 1 || day > daysInMonth(year, m) {
+		return JalaliDateTime{}, false
+	}
+	return ref.withDate(year, m, day), true
+}
+
+func relativeNumber(s string) (int, bool) {
+	if n, ok := relNumbers[s]; ok {
+		return n, true
+	}
+	n, err := strconv.Atoi(s)
+	return n, err == nil && n >= 0
+}
+
+// weekdayDelta returns the number of days from cur to the requested weekday.
+// Weeks start on Shanbe when dir is 0.
+func weekdayDelta(cur, wd Weekday, dir int) int {
+	switch {
+	case dir > 0:
+		if d := (int(wd) - int(cur) + 7) % 7; d != 0 {
+			return d
+		}
+		return 7
+	case dir < 0:
+		if d := (int(cur) - int(wd) + 7) % 7; d != 0 {
+			return -d
+		}
+		return -7
+	}
+	return weekIndex(wd) - weekIndex(cur)
+}
+
+// weekIndex returns the position of w in a week starting on Shanbe.
+func weekIndex(w Weekday) int {
+	return (int(w) + 1) % 7
+}
+
+// maxRelativeDays bounds the offsets applied by addRelative. A larger one
+// leaves the years 1-9999 from any starting point, and could overflow the
+// arithmetic.
+const maxRelativeDays = 10000 * 366
+
+// relUnitLimits holds the largest count of each unit addRelative applies.
+var relUnitLimits = map[relUnit]int{
+	relSecond: maxRelativeDays * 86400,
+	relMinute: maxRelativeDays * 1440,
+	relHour:   maxRelativeDays * 24,
+	relDay:    maxRelativeDays,
+	relWeek:   maxRelativeDays / 7,
+	relMonth:  10000 * 12,
+	relYear:   10000,
+}
+
+// addRelative moves j by n units. An offset that cannot stay in the years
+// 1-9999 gives the zero JalaliDateTime, whose year is out of range.
+func addRelative(j JalaliDateTime, unit relUnit, n int) JalaliDateTime {
+	if limit := relUnitLimits[unit]; n > limit || n < -limit {
+		return JalaliDateTime{}
+	}
+	switch unit {
+	case relSecond:
+		return addClock(j, int64(n), time.Second)
+	case relMinute:
+		return addClock(j, int64(n), time.Minute)
+	case relHour:
+		return addClock(j, int64(n), time.Hour)
+	case relDay:
+		return j.AddDays(n)
+	case relWeek:
+		return j.AddDays(7 * n)
+	case relMonth:
+		return j.AddMonths(n)
+	default:
+		return j.AddYears(n)
+	}
+}
+
+// addClock adds n units of d to j in steps small enough for a time.Duration.
+func addClock(j JalaliDateTime, n int64, d time.Duration) JalaliDateTime {
+	step := int64(100 * 365 * 24 * time.Hour / d)
+	for n > step {
+		j, n = j.Add(time.Duration(step)*d), n-step
+	}
+	for n < -step {
+		j, n = j.Add(-time.Duration(step)*d), n+step
+	}
+	return j.Add(time.Duration(n) * d)
+}
+
+// periodEdge returns the first or last day of the week, month or year
+// containing j.
+func periodEdge(j JalaliDateTime, unit relUnit, end bool) (JalaliDateTime, bool) {
+	switch unit {
+	case relWeek:
+		idx := weekIndex(j.Weekday())
+		if end {
+			return j.AddDays(6 - idx), true
+		}
+		return j.AddDays(-idx), true
+	case relMonth:
+		return monthEdge(j, j.Year(), j.Month(), end), true
+	case relYear:
+		if end {
+			return monthEdge(j, j.Year(), Esfand, true), true
+		}
+		return monthEdge(j, j.Year(), Farvardin, false), true
+	}
+	return JalaliDateTime{}, false
+}
+
+func monthEdge(j JalaliDateTime, year int, m Month, end bool) JalaliDateTime {
+	if end {
+		return j.withDate(year, m, daysInMonth(year, m))
+	}
+	return j.withDate(year, m, 1)
+}
+
+// withDate returns j moved to the given date, keeping its time of day, or
+// the zero JalaliDateTime if the year is out of range.
+func (j JalaliDateTime) withDate(year int, month Month, day int) JalaliDateTime {
+	if year < 1 || year > 9999 {
+		return JalaliDateTime{}
+	}
+	return Date(year, month, day, j.hour, j.min, j.sec, j.nanosec, j.location)
+}
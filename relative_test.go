@@ -0,0 +1,128 @@
+package golali_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/bijanghanei/golali"
+)
+
+func TestParseRelative(t *testing.T) {
+	// 1403/07/15 is a Yekshanbe (Sunday).
+	ref := golali.Date(1403, golali.Mehr, 15, 10, 20, 30, 0, time.UTC)
+
+	tests := []struct {
+		text  string
+		year  int
+		month golali.Month
+		day   int
+	}{
+		{"امروز", 1403, golali.Mehr, 15},
+		{"today", 1403, golali.Mehr, 15},
+		{"فردا", 1403, golali.Mehr, 16},
+		{"پس‌فردا", 1403, golali.Mehr, 17},
+		{"پس فردا", 1403, golali.Mehr, 17},
+		{"day after tomorrow", 1403, golali.Mehr, 17},
+		{"dirooz", 1403, golali.Mehr, 14},
+		{"۳ روز پیش", 1403, golali.Mehr, 12},
+		{"سه روز قبل", 1403, golali.Mehr, 12},
+		{"2 weeks later", 1403, golali.Mehr, 29},
+		{"in 3 days", 1403, golali.Mehr, 18},
+		{"هفته بعد", 1403, golali.Mehr, 22},
+		{"next week", 1403, golali.Mehr, 22},
+		{"2 months ago", 1403, golali.Mordad, 15},
+		{"ماه آینده", 1403, golali.Aban, 15},
+		{"سال گذشته", 1402, golali.Mehr, 15},
+		{"اول ماه آینده", 1403, golali.Aban, 1},
+		{"start of next month", 1403, golali.Aban, 1},
+		{"آخر ماه", 1403, golali.Mehr, 30},
+		{"end of the year", 1403, golali.Esfand, 30},
+		{"اول هفته", 1403, golali.Mehr, 14},
+		{"آخر هفته", 1403, golali.Mehr, 20},
+		{"جمعه", 1403, golali.Mehr, 20},
+		{"next Friday", 1403, golali.Mehr, 20},
+		{"jomeh ayande", 1403, golali.Mehr, 20},
+		{"شنبه گذشته", 1403, golali.Mehr, 14},
+		{"یکشنبه بعد", 1403, golali.Mehr, 22},
+		{"last sunday", 1403, golali.Mehr, 8},
+		{"سه‌شنبه", 1403, golali.Mehr, 17},
+		{"این سه شنبه", 1403, golali.Mehr, 17},
+		{"۱۵ مهر", 1403, golali.Mehr, 15},
+		{"1 Farvardin 1404", 1404, golali.Farvardin, 1},
+		{"۲۰ دی ماه", 1403, golali.Dey, 20},
+		{"مهر آینده", 1404, golali.Mehr, 1},
+		{"last Esfand", 1402, golali.Esfand, 1},
+		{"end of Esfand", 1403, golali.Esfand, 30},
+	}
+
+	for _, tt := range tests {
+		got, err := golali.ParseRelative(tt.text, ref)
+		if err != nil {
+			t.Errorf("ParseRelative(%q) failed: %v", tt.text, err)
+			continue
+		}
+		if got.Year() != tt.year || got.Month() != tt.month || got.Day() != tt.day {
+			t.Errorf("ParseRelative(%q) = %v, want %04d/%02d/%02d", tt.text, got, tt.year, tt.month, tt.day)
+		}
+		if got.Hour() != 10 || got.Minute() != 20 || got.Second() != 30 {
+			t.Errorf("ParseRelative(%q) = %v, time of day not preserved", tt.text, got)
+		}
+	}
+}
+
+func TestParseRelativeClock(t *testing.T) {
+	ref := golali.Date(1403, golali.Mehr, 15, 10, 20, 30, 0, time.UTC)
+	got, err := golali.ParseRelative("۵ ساعت پیش", ref)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := ref.Add(-5 * time.Hour); got != want {
+		t.Errorf("ParseRelative(5 hours ago) = %v, want %v", got, want)
+	}
+}
+
+func TestParseRelativeUnknown(t *testing.T) {
+	ref := golali.Date(1403, golali.Mehr, 15, 0, 0, 0, 0, time.UTC)
+	for _, text := range []string{"", "someday", "۳۱ مهر", "3 days", "in week", "week in"} {
+		_, err := golali.ParseRelative(text, ref)
+		var pe *golali.ParseError
+		if !errors.As(err, &pe) || pe.Kind != golali.Mismatch {
+			t.Errorf("ParseRelative(%q) error = %v, want Mismatch", text, err)
+		}
+	}
+}
+
+func TestParseRelativeOutOfRange(t *testing.T) {
+	ref := golali.Date(1403, golali.Mehr, 15, 0, 0, 0, 0, time.UTC)
+	last := golali.Date(9999, golali.Esfand, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		text string
+		ref  golali.JalaliDateTime
+	}{
+		{"10000 years later", ref},
+		{"9999999999 days later", ref},
+		{"9999999999 days ago", ref},
+		{"99999999999999 seconds later", ref},
+		{"2000 years ago", ref},
+		{"next year", last},
+		{"end of next year", last},
+		{"فروردین آینده", last},
+	}
+	for _, tt := range tests {
+		_, err := golali.ParseRelative(tt.text, tt.ref)
+		var pe *golali.ParseError
+		if !errors.As(err, &pe) || pe.Kind != golali.OutOfRange {
+			t.Errorf("ParseRelative(%q, %v) error = %v, want OutOfRange", tt.text, tt.ref, err)
+		}
+	}
+
+	// Large offsets that stay in range still work.
+	got, err := golali.ParseRelative("2000000 hours later", ref)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := ref.Add(2000000 * time.Hour); !got.Equal(want) {
+		t.Errorf("ParseRelative(2000000 hours later) = %v, want %v", got, want)
+	}
+}
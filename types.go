@@ -56,6 +56,23 @@ const (
 	Esfand
 )
 
+// Afghan names of the months. Afghanistan uses the same solar calendar
+// but names the months after the signs of the zodiac.
+const (
+	Hamal   = Farvardin
+	Sawr    = Ordibehesht
+	Jawza   = Khordad
+	Saratan = Tir
+	Asad    = Mordad
+	Sunbula = Shahrivar
+	Mizan   = Mehr
+	Aqrab   = Aban
+	Qaws    = Azar
+	Jadi    = Dey
+	Dalw    = Bahman
+	Hut     = Esfand
+)
+
 // String returns the English name of the month.
 func (m Month) String() string {
 	if m < 1 || m > 12 {
@@ -72,12 +89,36 @@ func (m Month) FaString() string {
 	return FaJalaliMonthName[m]
 }
 
+// DariString returns the Dari name of the month as used in Afghanistan.
+func (m Month) DariString() string {
+	return dariMonths[m.index()]
+}
+
+// PashtoString returns the Pashto name of the month as used in Afghanistan.
+func (m Month) PashtoString() string {
+	return pashtoMonths[m.index()]
+}
+
+// index returns the zero-based position of the month.
+func (m Month) index() int {
+	if m < 1 || m > 12 {
+		panic(fmt.Sprintf("invalid month value: %v", int(m)))
+	}
+	return int(m) - 1
+}
+
 // FaWeekDays contains the names of the weekdays in Persian.
 var FaWeekDays = []string{"یکشنبه", "دوشنبه", "سه‌شنبه", "چهارشنبه", "پنج‌شنبه", "جمعه", "شنبه"}
 
 // EnWeekDays contains the names of the weekdays in English.
 var EnWeekDays = []string{"1Shanbeh", "2Shanbeh", "3Shanbeh", "4Shanbeh", "5Shanbeh", "Joomeh", "Shanbeh"}
 
+// FinglishWeekDays contains the Persian names of the weekdays written in Latin letters.
+var FinglishWeekDays = []string{"Yekshanbeh", "Doshanbeh", "Seshanbeh", "Chaharshanbeh", "Panjshanbeh", "Joomeh", "Shanbeh"}
+
+// FaShortWeekDays contains the one-letter Persian abbreviations of the weekdays.
+var FaShortWeekDays = []string{"ی", "د", "س", "چ", "پ", "ج", "ش"}
+
 // Weekday represents a day of the week in the Jalali calendar.
 type Weekday int
 
@@ -107,16 +148,43 @@ func (w Weekday) FaString() string {
 	return FaWeekDays[w]
 }
 
+// DariString returns the Dari name of the weekday as used in Afghanistan.
+func (w Weekday) DariString() string {
+	return dariWeekdays[w.index()]
+}
+
+// PashtoString returns the Pashto name of the weekday.
+func (w Weekday) PashtoString() string {
+	return pashtoWeekdays[w.index()]
+}
+
+// index returns the position of the weekday in tables that start on Yekshanbe.
+func (w Weekday) index() int {
+	if w < 0 || w > 6 {
+		panic(fmt.Sprintf("invalid weekday value: %v", int(w)))
+	}
+	return int(w)
+}
+
 // JalaliDateTime represents a date and time in the Jalali calendar
 type JalaliDateTime struct {
-	year     int
-	month    Month
-	day      int
-	hour     int
-	min      int
-	sec      int
-	nanosec  int
-	location *time.Location
+	// t is the instant. Its wall clock matches the Jalali fields below.
+	t time.Time
+
+	// The Jalali wall clock, cached so that accessors need no conversion.
+	year    int
+	month   Month
+	day     int
+	hour    int
+	min     int
+	sec     int
+	nanosec int
+
+	location *time.Location // as given; nil means local time
+
+	// gap is the length of the daylight saving gap that the requested wall
+	// time fell in and was moved forward by, or zero.
+	gap time.Duration
 }
 
 // Location returns the location of the JalaliDateTime.
@@ -154,11 +222,15 @@ func (j JalaliDateTime) Second() int {
 	return j.sec
 }
 
+// Nanosecond returns the nanosecond offset within the second, in the range
+// [0, 999999999].
+func (j JalaliDateTime) Nanosecond() int {
+	return j.nanosec
+}
+
 // Weekday returns the day of the week of the Jalali date.
 func (j JalaliDateTime) Weekday() Weekday {
-	gYear, gMonth, gDay := jalaliToGregorian(j.year, int(j.month), j.day)
-	gDate := time.Date(gYear, time.Month(gMonth), gDay, j.hour, j.min, j.sec, j.nanosec, j.location)
-	weekday := gDate.Weekday()
+	weekday := j.t.Weekday()
 	switch weekday {
 	case time.Sunday:
 		return Yekshanbe
@@ -177,4 +249,13 @@ func (j JalaliDateTime) Weekday() Weekday {
 	default:
 		panic(fmt.Sprintf("invalid weekday value: %v", weekday))
 	}
-}
\ No newline at end of file
+}
+
+// YearDay returns the day of the year of the Jalali date, in the range
+// [1,365] for common years and [1,366] in leap years.
+func (j JalaliDateTime) YearDay() int {
+	if j.month <= Shahrivar {
+		return int(j.month-1)*31 + j.day
+	}
+	return 186 + int(j.month-Mehr)*30 + j.day
+}
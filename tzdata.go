@@ -0,0 +1,20 @@
+//go:build !golali_notzdata
+
+package golali
+
+import "embed"
+
+// zoneinfo holds the TZif rules of the zones the package names, so that
+// IRST and AFT work without a system time zone database and give the same
+// historical offsets, including Iran's daylight saving periods, on every
+// host. Build with the golali_notzdata tag to leave them out and use the
+// system database instead.
+//
+//go:embed zoneinfo/Asia/Tehran zoneinfo/Asia/Kabul
+var zoneinfo embed.FS
+
+// embeddedZone returns the embedded TZif data for the named zone.
+func embeddedZone(name string) ([]byte, bool) {
+	data, err := zoneinfo.ReadFile("zoneinfo/" + name)
+	return data, err == nil
+}
@@ -0,0 +1,8 @@
+//go:build golali_notzdata
+
+package golali
+
+// embeddedZone reports that no zone data is embedded in this build.
+func embeddedZone(name string) ([]byte, bool) {
+	return nil, false
+}
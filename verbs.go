@@ -0,0 +1,154 @@
+package golali
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Format implements fmt.Formatter. The verbs are:
+//
+//	%v, %s  English name, as String
+//	%+v     Persian name, as FaString
+//	%q      quoted English name
+//	%d      month number
+//	%#v     Go syntax, such as golali.Mehr
+//
+// Width, precision and flags apply as for strings and integers.
+func (m Month) Format(f fmt.State, verb rune) {
+	valid := m >= Farvardin && m <= Esfand
+	switch {
+	case verb == 'd':
+		fmt.Fprintf(f, fmt.FormatString(f, verb), int(m))
+	case !valid:
+		fmt.Fprintf(f, "%%!Month(%d)", int(m))
+	case verb == 'v' && f.Flag('#'):
+		fmt.Fprint(f, "golali."+m.String())
+	case verb == 'v' && f.Flag('+'):
+		fmt.Fprintf(f, fmt.FormatString(f, 's'), m.FaString())
+	case verb == 'v' || verb == 's' || verb == 'q':
+		if verb == 'v' {
+			verb = 's'
+		}
+		fmt.Fprintf(f, fmt.FormatString(f, verb), m.String())
+	default:
+		fmt.Fprintf(f, "%%!%c(golali.Month=%d)", verb, int(m))
+	}
+}
+
+// Format implements fmt.Formatter. The verbs are:
+//
+//	%v, %s  English name, as String
+//	%+v     Persian name, as FaString
+//	%q      quoted English name
+//	%d      weekday number, 0 for Yekshanbe
+//	%#v     Go syntax, such as golali.Joomeh
+//
+// Width, precision and flags apply as for strings and integers.
+func (w Weekday) Format(f fmt.State, verb rune) {
+	valid := w >= Yekshanbe && w <= Shanbe
+	switch {
+	case verb == 'd':
+		fmt.Fprintf(f, fmt.FormatString(f, verb), int(w))
+	case !valid:
+		fmt.Fprintf(f, "%%!Weekday(%d)", int(w))
+	case verb == 'v' && f.Flag('#'):
+		fmt.Fprint(f, "golali."+weekdayConstNames[w])
+	case verb == 'v' && f.Flag('+'):
+		fmt.Fprintf(f, fmt.FormatString(f, 's'), w.FaString())
+	case verb == 'v' || verb == 's' || verb == 'q':
+		if verb == 'v' {
+			verb = 's'
+		}
+		fmt.Fprintf(f, fmt.FormatString(f, verb), w.String())
+	default:
+		fmt.Fprintf(f, "%%!%c(golali.Weekday=%d)", verb, int(w))
+	}
+}
+
+// weekdayConstNames holds the identifiers of the Weekday constants.
+var weekdayConstNames = [7]string{"Yekshanbe", "Doshanbe", "Seshanbe", "Chaharshanbe", "Panjshanbe", "Joomeh", "Shanbe"}
+
+// GoString implements fmt.GoStringer and formats j as a call to Date, such as
+// golali.Date(1403, golali.Mehr, 15, 10, 20, 30, 0, golali.IRST()).
+func (j JalaliDateTime) GoString() string {
+	month := strconv.Itoa(int(j.month))
+	if j.month >= Farvardin && j.month <= Esfand {
+		month = "golali." + j.month.String()
+	}
+	return fmt.Sprintf("golali.Date(%d, %s, %d, %d, %d, %d, %d, %s)",
+		j.year, month, j.day, j.hour, j.min, j.sec, j.nanosec, goLocation(j))
+}
+
+// goLocation returns a Go expression for the location of j. A zone without
+// transitions, such as one made by time.FixedZone, is written as a call to
+// time.FixedZone; other zones are loaded by name.
+func goLocation(j JalaliDateTime) string {
+	loc := j.location
+	switch {
+	case loc == nil:
+		return "nil"
+	case loc == time.UTC:
+		return "time.UTC"
+	case loc == time.Local:
+		return "time.Local"
+	}
+	name := loc.String()
+	switch name {
+	case "Asia/Tehran":
+		return "golali.IRST()"
+	case "Asia/Kabul":
+		return "golali.AFT()"
+	}
+	if start, end := j.t.ZoneBounds(); start.IsZero() && end.IsZero() {
+		_, offset := j.t.Zone()
+		return "time.FixedZone(" + strconv.Quote(name) + ", " + strconv.Itoa(offset) + ")"
+	}
+	return "func() *time.Location { loc, _ := time.LoadLocation(" + strconv.Quote(name) + "); return loc }()"
+}
+
+// Printable is a JalaliDateTime that implements fmt.Formatter, for use in
+// log lines and other fmt output:
+//
+//	log.Printf("due %+v", golali.Printable(j))
+//
+// JalaliDateTime cannot implement fmt.Formatter itself because its Format
+// method takes a layout. The verbs are:
+//
+//	%v, %s  the String form, 1403/07/15 10:20:30
+//	%+s     the String form in Persian digits, ۱۴۰۳/۰۷/۱۵ ۱۰:۲۰:۳۰
+//	%+v     the long Persian form, یکشنبه ۱۵ مهر ۱۴۰۳ ساعت ۱۰:۲۰:۳۰
+//	%q      the quoted String form
+//	%d      the date as a number, 14030715
+//	%#v     Go syntax, as GoString
+//
+// Width, precision and flags apply as for strings and integers.
+type Printable JalaliDateTime
+
+// Format implements fmt.Formatter.
+func (p Printable) Format(f fmt.State, verb rune) {
+	j := JalaliDateTime(p)
+	switch verb {
+	case 'v', 's', 'q':
+		var s string
+		switch {
+		case verb == 'v' && f.Flag('#'):
+			fmt.Fprint(f, j.GoString())
+			return
+		case verb == 'v' && f.Flag('+'):
+			s = j.FormatLocale("%w %d %B %Y ساعت %T", PersianLocale())
+		case verb == 's' && f.Flag('+'):
+			s = j.StringWith(FormatOptions{Digits: PersianDigits})
+		default:
+			s = j.String()
+		}
+		if verb == 'v' {
+			verb = 's'
+		}
+		fmt.Fprintf(f, fmt.FormatString(f, verb), s)
+	case 'd':
+		fmt.Fprintf(f, fmt.FormatString(f, verb), j.year*10000+int(j.month)*100+j.day)
+	default:
+		fmt.Fprintf(f, "%%!%c(golali.JalaliDateTime=%s)", verb, j.String())
+	}
+}
@@ -0,0 +1,79 @@
+package golali_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/bijanghanei/golali"
+)
+
+func TestMonthVerbs(t *testing.T) {
+	tests := []struct {
+		format string
+		arg    any
+		want   string
+	}{
+		{"%v", golali.Mehr, "Mehr"},
+		{"%s", golali.Mehr, "Mehr"},
+		{"%+v", golali.Mehr, "مهر"},
+		{"%q", golali.Mehr, `"Mehr"`},
+		{"%d", golali.Mehr, "7"},
+		{"%02d", golali.Mehr, "07"},
+		{"%-6s|", golali.Dey, "Dey   |"},
+		{"%#v", golali.Mehr, "golali.Mehr"},
+		{"%v", golali.Month(13), "%!Month(13)"},
+		{"%v", golali.Joomeh, "Joomeh"},
+		{"%+v", golali.Joomeh, "جمعه"},
+		{"%d", golali.Joomeh, "5"},
+		{"%#v", golali.Seshanbe, "golali.Seshanbe"},
+		{"%v", golali.Weekday(9), "%!Weekday(9)"},
+	}
+	for _, tt := range tests {
+		if got := fmt.Sprintf(tt.format, tt.arg); got != tt.want {
+			t.Errorf("Sprintf(%q, %d) = %q, want %q", tt.format, tt.arg, got, tt.want)
+		}
+	}
+}
+
+func TestDateTimeVerbs(t *testing.T) {
+	// 1403/07/15 is a Yekshanbe (Sunday).
+	j := golali.Date(1403, golali.Mehr, 15, 10, 20, 30, 0, golali.IRST())
+	p := golali.Printable(j)
+	tests := []struct {
+		format string
+		arg    any
+		want   string
+	}{
+		{"%v", p, "1403/07/15 10:20:30"},
+		{"%s", p, "1403/07/15 10:20:30"},
+		{"%+s", p, "۱۴۰۳/۰۷/۱۵ ۱۰:۲۰:۳۰"},
+		{"%+v", p, "یکشنبه ۱۵ مهر ۱۴۰۳ ساعت ۱۰:۲۰:۳۰"},
+		{"%q", p, `"1403/07/15 10:20:30"`},
+		{"%.10s", p, "1403/07/15"},
+		{"%d", p, "14030715"},
+		{"%#v", p, "golali.Date(1403, golali.Mehr, 15, 10, 20, 30, 0, golali.IRST())"},
+		{"%#v", j, "golali.Date(1403, golali.Mehr, 15, 10, 20, 30, 0, golali.IRST())"},
+		{"%v", j, "1403/07/15 10:20:30"},
+		{"%#v", golali.Date(1403, golali.Dey, 1, 0, 0, 0, 5, time.UTC), "golali.Date(1403, golali.Dey, 1, 0, 0, 0, 5, time.UTC)"},
+		{"%#v", golali.Date(1403, golali.Dey, 1, 0, 0, 0, 0, time.FixedZone("X", 3600)), `golali.Date(1403, golali.Dey, 1, 0, 0, 0, 0, time.FixedZone("X", 3600))`},
+		{"%#v", golali.Date(1403, golali.Dey, 1, 0, 0, 0, 0, time.FixedZone("", -12600)), `golali.Date(1403, golali.Dey, 1, 0, 0, 0, 0, time.FixedZone("", -12600))`},
+	}
+	for _, tt := range tests {
+		if got := fmt.Sprintf(tt.format, tt.arg); got != tt.want {
+			t.Errorf("Sprintf(%q) = %q, want %q", tt.format, got, tt.want)
+		}
+	}
+}
+
+func TestGoStringNamedZone(t *testing.T) {
+	paris, err := time.LoadLocation("Europe/Paris")
+	if err != nil {
+		t.Skip(err)
+	}
+	j := golali.Date(1403, golali.Dey, 1, 0, 0, 0, 0, paris)
+	want := `golali.Date(1403, golali.Dey, 1, 0, 0, 0, 0, func() *time.Location { loc, _ := time.LoadLocation("Europe/Paris"); return loc }())`
+	if got := fmt.Sprintf("%#v", j); got != want {
+		t.Errorf("Sprintf(%%#v) = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,145 @@
+package golali
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// WallTimePolicy decides which instant a wall time denotes when a time zone
+// transition makes it occur never (a gap, when clocks spring forward) or
+// twice (an overlap, when clocks fall back).
+type WallTimePolicy int
+
+const (
+	// ResolveCompatible moves a time in a gap forward by the length of the
+	// gap and takes the earlier of two occurrences, as RFC 5545 does.
+	// It is the behaviour of ToTime.
+	ResolveCompatible WallTimePolicy = iota
+
+	// ResolveEarlier takes the earlier instant: in a gap, the wall time read
+	// with the offset in force after the transition.
+	ResolveEarlier
+
+	// ResolveLater takes the later instant: in a gap, the wall time read
+	// with the offset in force before the transition.
+	ResolveLater
+
+	// ResolveShiftForward moves a time in a gap to the first instant after
+	// it and takes the earlier of two occurrences.
+	ResolveShiftForward
+
+	// ResolveReject reports ErrNonexistentTime or ErrAmbiguousTime.
+	ResolveReject
+)
+
+var (
+	// ErrNonexistentTime is returned for a wall time skipped by a transition.
+	ErrNonexistentTime = errors.New("golali: wall time does not exist in location")
+
+	// ErrAmbiguousTime is returned for a wall time that occurs twice.
+	ErrAmbiguousTime = errors.New("golali: wall time is ambiguous in location")
+)
+
+// IsNonexistent reports whether j was built from a wall time skipped in its
+// location, such as 00:30 on the day Tehran moved its clocks from 00:00 to
+// 01:00. Such a value already holds the time moved past the gap, 01:30.
+func (j JalaliDateTime) IsNonexistent() bool {
+	return j.gap != 0
+}
+
+// IsAmbiguous reports whether the wall time of j occurs twice in its
+// location, as happens in the hour after clocks are turned back.
+func (j JalaliDateTime) IsAmbiguous() bool {
+	return j.wallTimes().overlap
+}
+
+// Resolve applies the policy to the wall time j was built from. A wall time
+// that exists exactly once is returned unchanged; a time in a gap is moved to
+// a valid one, and a time in an overlap is fixed to one of its two instants.
+// With ResolveReject the error wraps ErrNonexistentTime or ErrAmbiguousTime.
+func (j JalaliDateTime) Resolve(policy WallTimePolicy) (JalaliDateTime, error) {
+	if j.gap != 0 {
+		// j.t is the requested wall time read with the offset in force
+		// before the transition; going back by the gap reads it with the
+		// offset after.
+		earlier := j.t.Add(-j.gap)
+		switch policy {
+		case ResolveReject:
+			_, off := j.t.Zone()
+			wall := ToJalaliDateTime(earlier.In(time.FixedZone("", off)))
+			return JalaliDateTime{}, fmt.Errorf("%w: %v in %v", ErrNonexistentTime, wall, j.t.Location())
+		case ResolveEarlier:
+			return ToJalaliDateTime(earlier), nil
+		case ResolveShiftForward:
+			start, _ := j.t.ZoneBounds()
+			return ToJalaliDateTime(start), nil
+		default:
+			return ToJalaliDateTime(j.t), nil
+		}
+	}
+	if w := j.wallTimes(); w.overlap {
+		switch policy {
+		case ResolveReject:
+			return JalaliDateTime{}, fmt.Errorf("%w: %v in %v", ErrAmbiguousTime, j, w.earlier.Location())
+		case ResolveLater:
+			j.t = w.later
+		case ResolveCompatible:
+		default:
+			j.t = w.earlier
+		}
+	}
+	return j, nil
+}
+
+// wallTimes describes the instants at which a wall time occurs.
+type wallTimes struct {
+	earlier, later time.Time // equal unless gap or overlap is set
+	gap            bool      // the wall time is skipped; earlier and later straddle the gap
+	overlap        bool      // the wall time occurs at both earlier and later
+}
+
+// transitionMargin bounds the distance from a zone transition within which
+// a wall time is examined for gaps and overlaps. It exceeds any real shift.
+const transitionMargin = 48 * time.Hour
+
+// wallTimes finds the instants at which the wall time of j occurs in its
+// location.
+func (j JalaliDateTime) wallTimes() wallTimes {
+	loc := j.location
+	if loc == nil {
+		loc = time.Local
+	}
+	gYear, gMonth, gDay := jalaliToGregorian(j.year, int(j.month), j.day)
+	t := time.Date(gYear, time.Month(gMonth), gDay, j.hour, j.min, j.sec, j.nanosec, loc)
+	start, end := t.ZoneBounds()
+	if (start.IsZero() || t.Sub(start) > transitionMargin) && (end.IsZero() || end.Sub(t) > transitionMargin) {
+		return wallTimes{earlier: t, later: t}
+	}
+
+	// Read the wall clock with the offsets in force on either side of the
+	// transition and keep the readings that fall under their own offset.
+	wall := time.Date(gYear, time.Month(gMonth), gDay, j.hour, j.min, j.sec, j.nanosec, time.UTC)
+	var cand [2]time.Time
+	var valid [2]bool
+	for i, probe := range [2]time.Duration{-transitionMargin, transitionMargin} {
+		_, off := wall.Add(probe).In(loc).Zone()
+		cand[i] = wall.Add(-time.Duration(off) * time.Second).In(loc)
+		_, got := cand[i].Zone()
+		valid[i] = got == off
+	}
+	if cand[1].Before(cand[0]) {
+		cand[0], cand[1] = cand[1], cand[0]
+		valid[0], valid[1] = valid[1], valid[0]
+	}
+	switch {
+	case valid[0] && valid[1] && !cand[0].Equal(cand[1]):
+		return wallTimes{earlier: cand[0], later: cand[1], overlap: true}
+	case valid[0]:
+		return wallTimes{earlier: cand[0], later: cand[0]}
+	case valid[1]:
+		return wallTimes{earlier: cand[1], later: cand[1]}
+	default:
+		return wallTimes{earlier: cand[0], later: cand[1], gap: true}
+	}
+}
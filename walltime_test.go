@@ -0,0 +1,129 @@
+package golali_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/bijanghanei/golali"
+)
+
+// On 1390/01/02 (2011-03-22) Tehran moved its clocks from 00:00 to 01:00,
+// and on 1390/06/30 (2011-09-21) back from 24:00 to 23:00.
+
+func TestWallTimeGap(t *testing.T) {
+	irst := golali.IRST()
+	j := golali.Date(1390, golali.Farvardin, 2, 0, 30, 0, 0, irst)
+	if !j.IsNonexistent() || j.IsAmbiguous() {
+		t.Fatalf("IsNonexistent = %v, IsAmbiguous = %v", j.IsNonexistent(), j.IsAmbiguous())
+	}
+	tests := []struct {
+		policy golali.WallTimePolicy
+		want   string
+	}{
+		{golali.ResolveCompatible, "1390/01/02 01:30:00"},
+		{golali.ResolveLater, "1390/01/02 01:30:00"},
+		{golali.ResolveEarlier, "1390/01/01 23:30:00"},
+		{golali.ResolveShiftForward, "1390/01/02 01:00:00"},
+	}
+	for _, tt := range tests {
+		got, err := j.Resolve(tt.policy)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.String() != tt.want || got.IsNonexistent() {
+			t.Errorf("Resolve(%v) = %v, want %v", tt.policy, got, tt.want)
+		}
+	}
+	if _, err := j.Resolve(golali.ResolveReject); !errors.Is(err, golali.ErrNonexistentTime) {
+		t.Errorf("Resolve(ResolveReject) error = %v", err)
+	}
+	if got := golali.ToJalaliDateTime(j.ToTime()).String(); got != "1390/01/02 01:30:00" {
+		t.Errorf("ToTime of a gap time = %v", got)
+	}
+
+	// The fields follow the instant, so formatting and parsing agree.
+	if got := j.Format("%T %z"); got != "01:30:00 +0430" {
+		t.Errorf("Format(%%T %%z) of a gap time = %q", got)
+	}
+	iso := j.FormatISO()
+	back, err := golali.ParseISO(iso)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !back.Equal(j) {
+		t.Errorf("ParseISO(%q) = %v, want %v", iso, back.ToTime(), j.ToTime())
+	}
+}
+
+func TestWallTimeOverlap(t *testing.T) {
+	irst := golali.IRST()
+	j := golali.Date(1390, golali.Shahrivar, 30, 23, 30, 0, 0, irst)
+	if !j.IsAmbiguous() || j.IsNonexistent() {
+		t.Fatalf("IsAmbiguous = %v, IsNonexistent = %v", j.IsAmbiguous(), j.IsNonexistent())
+	}
+	offset := func(j golali.JalaliDateTime) int {
+		_, off := j.Zone()
+		return off
+	}
+	for policy, want := range map[golali.WallTimePolicy]int{
+		golali.ResolveCompatible:   16200,
+		golali.ResolveEarlier:      16200,
+		golali.ResolveShiftForward: 16200,
+		golali.ResolveLater:        12600,
+	} {
+		got, err := j.Resolve(policy)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if offset(got) != want || got.String() != j.String() {
+			t.Errorf("Resolve(%v) = %v with offset %d, want offset %d", policy, got, offset(got), want)
+		}
+	}
+	if _, err := j.Resolve(golali.ResolveReject); !errors.Is(err, golali.ErrAmbiguousTime) {
+		t.Errorf("Resolve(ResolveReject) error = %v", err)
+	}
+
+	// Both occurrences survive a round trip through time.Time.
+	first := j.ToTime()
+	second := first.Add(time.Hour)
+	for _, tm := range []time.Time{first, second} {
+		if back := golali.ToJalaliDateTime(tm).ToTime(); !back.Equal(tm) {
+			t.Errorf("round trip of %v gave %v", tm, back)
+		}
+	}
+	if next := golali.Date(1390, golali.Mehr, 1, 0, 30, 0, 0, irst); next.IsAmbiguous() || next.IsNonexistent() {
+		t.Errorf("%v is reported as a transition time", next)
+	}
+}
+
+func TestParserWallTime(t *testing.T) {
+	irst := golali.IRST()
+	p := golali.Parser{Location: irst, WallTime: golali.ResolveReject}
+	_, err := p.Parse(golali.DateTime, "1390/01/02 00:30:00")
+	var pe *golali.ParseError
+	if !errors.As(err, &pe) || pe.Kind != golali.OutOfRange {
+		t.Errorf("Parse of a gap time error = %v", err)
+	}
+	if _, err := p.Parse(golali.DateTime, "1390/06/30 23:30:00"); err == nil {
+		t.Error("Parse of an ambiguous time succeeded with ResolveReject")
+	}
+	if _, err := p.Parse(golali.DateTime, "1390/06/30 22:30:00"); err != nil {
+		t.Errorf("Parse of a normal time: %v", err)
+	}
+
+	p.WallTime = golali.ResolveShiftForward
+	j, err := p.Parse(golali.DateTime, "1390/01/02 00:30:00")
+	if err != nil || j.String() != "1390/01/02 01:00:00" {
+		t.Errorf("ResolveShiftForward parse = %v, %v", j, err)
+	}
+
+	// An offset in the value selects the occurrence and keeps the location.
+	j, err = p.Parse(golali.ISODateTime, "1390-06-30T23:30:00+0330")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, off := j.Zone(); off != 12600 || j.Location() != irst {
+		t.Errorf("parsed offset %d in %v", off, j.Location())
+	}
+}
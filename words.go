@@ -0,0 +1,133 @@
+package golali
+
+import "bytes"
+
+// WordsOptions controls how Words writes a date.
+// The zero value gives the formal style without the weekday, as in
+// "پانزدهم مهر یک هزار و چهارصد و سه".
+type WordsOptions struct {
+	// MonthSuffix writes "ماه" after the month name, as in "مهر ماه".
+	MonthSuffix bool
+
+	// Informal selects everyday wording: the first day is "اول" rather than
+	// "یکم" and years 1000–1999 start with "هزار" rather than "یک هزار".
+	Informal bool
+
+	// Weekday writes the name of the weekday before the date.
+	Weekday bool
+}
+
+// Words returns the date written out in Persian words, as used in formal
+// letters, contracts and cheques:
+//
+//	j.Words(golali.WordsOptions{MonthSuffix: true})
+//	// پانزدهم مهر ماه یک هزار و چهارصد و سه
+//
+// The month and weekday names are taken from FaJalaliMonthName and FaWeekDays.
+func (j JalaliDateTime) Words(opts WordsOptions) string {
+	formal := !opts.Informal
+	var b []byte
+	if opts.Weekday {
+		b = append(b, FaWeekDays[j.Weekday()]...)
+		b = append(b, "، "...)
+	}
+	b = appendOrdinalWords(b, j.day, formal)
+	b = append(b, ' ')
+	b = append(b, FaJalaliMonthName[j.month]...)
+	if opts.MonthSuffix {
+		b = append(b, " ماه"...)
+	}
+	b = append(b, ' ')
+	b = appendWords(b, j.year, formal)
+	return string(b)
+}
+
+var (
+	faOnes = [20]string{
+		"صفر", "یک", "دو", "سه", "چهار", "پنج", "شش", "هفت", "هشت", "نه",
+		"ده", "یازده", "دوازده", "سیزده", "چهارده", "پانزده", "شانزده", "هفده", "هجده", "نوزده",
+	}
+	faTens     = [10]string{"", "", "بیست", "سی", "چهل", "پنجاه", "شصت", "هفتاد", "هشتاد", "نود"}
+	faHundreds = [10]string{"", "صد", "دویست", "سیصد", "چهارصد", "پانصد", "ششصد", "هفتصد", "هشتصد", "نهصد"}
+	faScales   = [7]string{"", "هزار", "میلیون", "میلیارد", "تریلیون", "کوادریلیون", "کوینتیلیون"}
+)
+
+// appendWords appends n written in Persian words. In the formal style a
+// single thousand is written "یک هزار", otherwise "هزار".
+func appendWords(b []byte, n int, formal bool) []byte {
+	if n == 0 {
+		return append(b, faOnes[0]...)
+	}
+	u := uint64(n)
+	if n < 0 {
+		b = append(b, "منفی "...)
+		u = -u
+	}
+	var groups [len(faScales)]int
+	top := 0
+	for ; u > 0; top++ {
+		groups[top] = int(u % 1000)
+		u /= 1000
+	}
+	first := true
+	for s := top - 1; s >= 0; s-- {
+		g := groups[s]
+		if g == 0 {
+			continue
+		}
+		if !first {
+			b = append(b, " و "...)
+		}
+		first = false
+		if s == 1 && g == 1 && !formal {
+			b = append(b, faScales[1]...)
+			continue
+		}
+		b = appendGroupWords(b, g)
+		if s > 0 {
+			b = append(b, ' ')
+			b = append(b, faScales[s]...)
+		}
+	}
+	return b
+}
+
+// appendGroupWords appends a number between 1 and 999 in words.
+func appendGroupWords(b []byte, g int) []byte {
+	h, r := g/100, g%100
+	if h > 0 {
+		b = append(b, faHundreds[h]...)
+		if r > 0 {
+			b = append(b, " و "...)
+		}
+	}
+	switch {
+	case r == 0:
+	case r < 20:
+		b = append(b, faOnes[r]...)
+	default:
+		b = append(b, faTens[r/10]...)
+		if r%10 > 0 {
+			b = append(b, " و "...)
+			b = append(b, faOnes[r%10]...)
+		}
+	}
+	return b
+}
+
+// appendOrdinalWords appends the ordinal of n in words, such as "پانزدهم".
+// The informal style writes the first as "اول" rather than "یکم".
+func appendOrdinalWords(b []byte, n int, formal bool) []byte {
+	if n == 1 && !formal {
+		return append(b, "اول"...)
+	}
+	b = appendWords(b, n, formal)
+	switch {
+	case bytes.HasSuffix(b, []byte("سه")):
+		return append(b[:len(b)-len("سه")], "سوم"...)
+	case bytes.HasSuffix(b, []byte("ی")):
+		return append(b, "‌ام"...)
+	default:
+		return append(b, "م"...)
+	}
+}
@@ -0,0 +1,67 @@
+package golali_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bijanghanei/golali"
+)
+
+func TestWords(t *testing.T) {
+	// 1403/07/15 is a Yekshanbe.
+	j := golali.Date(1403, golali.Mehr, 15, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		opts golali.WordsOptions
+		want string
+	}{
+		{golali.WordsOptions{}, "پانزدهم مهر یک هزار و چهارصد و سه"},
+		{golali.WordsOptions{MonthSuffix: true}, "پانزدهم مهر ماه یک هزار و چهارصد و سه"},
+		{golali.WordsOptions{Informal: true}, "پانزدهم مهر هزار و چهارصد و سه"},
+		{golali.WordsOptions{Weekday: true}, "یکشنبه، پانزدهم مهر یک هزار و چهارصد و سه"},
+	}
+	for _, tt := range tests {
+		if got := j.Words(tt.opts); got != tt.want {
+			t.Errorf("Words(%+v) = %q, want %q", tt.opts, got, tt.want)
+		}
+	}
+
+	days := []struct {
+		day            int
+		formal, casual string
+	}{
+		{1, "یکم", "اول"},
+		{2, "دوم", "دوم"},
+		{3, "سوم", "سوم"},
+		{10, "دهم", "دهم"},
+		{20, "بیستم", "بیستم"},
+		{23, "بیست و سوم", "بیست و سوم"},
+		{30, "سی‌ام", "سی‌ام"},
+		{31, "سی و یکم", "سی و یکم"},
+	}
+	for _, tt := range days {
+		d := golali.Date(1400, golali.Farvardin, tt.day, 0, 0, 0, 0, time.UTC)
+		want := tt.formal + " فروردین یک هزار و چهارصد"
+		if got := d.Words(golali.WordsOptions{}); got != want {
+			t.Errorf("Words for day %d = %q, want %q", tt.day, got, want)
+		}
+		want = tt.casual + " فروردین هزار و چهارصد"
+		if got := d.Words(golali.WordsOptions{Informal: true}); got != want {
+			t.Errorf("informal Words for day %d = %q, want %q", tt.day, got, want)
+		}
+	}
+
+	old := golali.Date(1357, golali.Bahman, 22, 0, 0, 0, 0, time.UTC)
+	if got := old.Words(golali.WordsOptions{}); got != "بیست و دوم بهمن یک هزار و سیصد و پنجاه و هفت" {
+		t.Errorf("Words = %q", got)
+	}
+}
+
+func TestFormatWordSpecifiers(t *testing.T) {
+	j := golali.Date(1403, golali.Mehr, 15, 0, 0, 0, 0, time.UTC)
+	if got, want := j.Format("%Od %B ماه %OY"), "پانزدهم مهر ماه یک هزار و چهارصد و سه"; got != want {
+		t.Errorf("Format = %q, want %q", got, want)
+	}
+	if got := j.Format("%Oq"); got != "%Oq" {
+		t.Errorf("Format(%%Oq) = %q", got)
+	}
+}